@@ -0,0 +1,186 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/audit"
+)
+
+// auditCommand is the subcommand that works on the audit log instead of serving.
+const auditCommand = "audit"
+
+const auditUsage = `Usage:
+  tazapay-mcp-server audit verify [--file PATH] [--partial]
+  tazapay-mcp-server audit export [--file PATH] [--from TIME] [--to TIME]
+
+verify checks that no entry of the audit log was modified, removed or reordered.
+--partial accepts a log that starts after the first entry, such as an export.
+export writes the entries in [from, to) to stdout; TIME is a date such as
+2025-01-31 (the whole day is included in --to) or an RFC 3339 time.
+PATH defaults to AUDIT_LOG_PATH.
+`
+
+// auditLogPath returns AUDIT_LOG_PATH, defaulting to a file in the home directory.
+func auditLogPath() (string, error) {
+	if path := viper.GetString("AUDIT_LOG_PATH"); path != "" {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate the audit log, set AUDIT_LOG_PATH: %w", err)
+	}
+
+	return filepath.Join(home, constants.DefaultAuditLogPath), nil
+}
+
+// openAuditLog opens the audit log that records every call of a tool that is
+// not read-only, or returns nil when AUDIT_DISABLED is set.
+func openAuditLog(logger *slog.Logger) (*audit.Log, error) {
+	if viper.GetBool("AUDIT_DISABLED") {
+		logger.Warn("Audit log disabled")
+		return nil, nil
+	}
+
+	path, err := auditLogPath()
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Writing audit log", slog.String("path", path))
+
+	log, err := audit.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if n := log.Discarded(); n > 0 {
+		logger.Warn("Removed an audit entry torn by a crash", slog.String("path", path), slog.Int64("bytes", n))
+	}
+
+	return log, nil
+}
+
+// runAudit runs the audit subcommand and returns the process exit code.
+func runAudit(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || (args[0] != "verify" && args[0] != "export") {
+		fmt.Fprint(stderr, auditUsage)
+		return 2
+	}
+
+	flags := pflag.NewFlagSet(auditCommand+" "+args[0], pflag.ContinueOnError)
+	flags.SetOutput(stderr)
+	file := flags.String("file", "", "Audit log file")
+	from := flags.String("from", "", "Export entries at or after this date or time")
+	to := flags.String("to", "", "Export entries before this time, or up to the end of this date")
+	partial := flags.Bool("partial", false, "Accept a log that starts after the first entry, such as an export")
+
+	if err := flags.Parse(args[1:]); err != nil {
+		return 2
+	}
+
+	if err := readConfig(); err != nil {
+		fmt.Fprintln(stderr, "failed to read config:", err)
+		return 1
+	}
+
+	if *file == "" {
+		path, err := auditLogPath()
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+
+		*file = path
+	}
+
+	f, err := os.Open(*file)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	defer f.Close()
+
+	if args[0] == "verify" {
+		return verifyAudit(f, *file, *partial, stdout, stderr)
+	}
+
+	return exportAudit(f, *from, *to, stdout, stderr)
+}
+
+// verifyAudit verifies the log in f, which must start at the first entry
+// unless partial is set.
+func verifyAudit(f io.Reader, name string, partial bool, stdout, stderr io.Writer) int {
+	s, err := audit.Verify(f, partial)
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", name, err)
+		return 1
+	}
+
+	if s.Entries == 0 {
+		fmt.Fprintf(stdout, "%s: empty\n", name)
+		return 0
+	}
+
+	fmt.Fprintf(stdout, "%s: OK, %d entries (%d to %d), head %s\n", name, s.Entries, s.FirstSeq, s.LastSeq, s.Head)
+
+	if s.Partial {
+		fmt.Fprintf(stdout, "The log starts at entry %d; its link to earlier entries was not checked.\n", s.FirstSeq)
+	}
+
+	return 0
+}
+
+// exportAudit writes the entries of f between from and to to stdout.
+func exportAudit(f io.Reader, from, to string, stdout, stderr io.Writer) int {
+	start, _, err := parseAuditTime(from)
+	if err != nil {
+		fmt.Fprintln(stderr, "invalid --from:", err)
+		return 2
+	}
+
+	end, date, err := parseAuditTime(to)
+	if err != nil {
+		fmt.Fprintln(stderr, "invalid --to:", err)
+		return 2
+	}
+
+	if date {
+		end = end.AddDate(0, 0, 1)
+	}
+
+	n, err := audit.Export(f, stdout, start, end)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	fmt.Fprintf(stderr, "Exported %d entries\n", n)
+
+	return 0
+}
+
+// parseAuditTime parses a date (in UTC) or an RFC 3339 time and reports
+// whether it was a date. An empty value is the zero time.
+func parseAuditTime(value string) (time.Time, bool, error) {
+	if value == "" {
+		return time.Time{}, false, nil
+	}
+
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, true, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+
+	return t, false, err
+}
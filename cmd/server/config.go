@@ -0,0 +1,357 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/auth"
+	"github.com/tazapay/tazapay-mcp-server/pkg/confirm"
+	logs "github.com/tazapay/tazapay-mcp-server/pkg/logs"
+	"github.com/tazapay/tazapay-mcp-server/pkg/store"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/transport"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/pkg/webhook"
+	"github.com/tazapay/tazapay-mcp-server/resources"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// bindFlags registers command line flags and binds them to their viper keys,
+// so every option can also be set via environment or config file.
+func bindFlags() {
+	pflag.String("transport", constants.TransportStdio, "MCP transport: stdio, sse or http")
+	pflag.String("listen-addr", constants.DefaultListenAddr, "Listen address for the sse and http transports")
+	pflag.String("base-url", "", "Public base URL advertised to SSE clients")
+	pflag.Duration("shutdown-timeout", constants.DefaultShutdownTimeout, "Graceful shutdown timeout")
+	pflag.String("log-level", constants.DefaultLogLevel, "Log level: debug, info, warn (or warning) or error")
+	pflag.Parse()
+
+	_ = viper.BindPFlag("TRANSPORT", pflag.Lookup("transport"))
+	_ = viper.BindPFlag("LISTEN_ADDR", pflag.Lookup("listen-addr"))
+	_ = viper.BindPFlag("PUBLIC_BASE_URL", pflag.Lookup("base-url"))
+	_ = viper.BindPFlag("SHUTDOWN_TIMEOUT", pflag.Lookup("shutdown-timeout"))
+	_ = viper.BindPFlag("LOG_LEVEL", pflag.Lookup("log-level"))
+}
+
+// logConfig builds the logger configuration. LOG_LEVEL sets the initial
+// level, which level then holds so that logging/setLevel can change it.
+func logConfig(level *slog.LevelVar) (logs.Config, error) {
+	viper.SetDefault("LOG_FORMAT", constants.DefaultLogFormat)
+	viper.SetDefault("LOG_MAX_SIZE_MB", constants.DefaultLogMaxSizeMB)
+	viper.SetDefault("LOG_MAX_BACKUPS", constants.DefaultLogMaxBackups)
+	viper.SetDefault("LOG_RETENTION", constants.DefaultLogRetention)
+
+	if _, err := logs.ParseLevel(viper.GetString("LOG_LEVEL")); err != nil {
+		return logs.Config{}, err
+	}
+
+	if err := logs.CheckFormat(viper.GetString("LOG_FORMAT")); err != nil {
+		return logs.Config{}, err
+	}
+
+	redactRules, err := logs.ParseRules(utils.GetStringList("LOG_REDACT_RULES"))
+	if err != nil {
+		return logs.Config{}, err
+	}
+
+	return logs.Config{
+		FilePath: viper.GetString("LOG_FILE_PATH"),
+		Format:   viper.GetString("LOG_FORMAT"),
+		Level:    viper.GetString("LOG_LEVEL"),
+		LevelVar: level,
+		Stderr:   viper.GetBool("LOG_STDERR"),
+
+		MaxSizeMB:  viper.GetInt("LOG_MAX_SIZE_MB"),
+		MaxAge:     viper.GetDuration("LOG_MAX_AGE"),
+		MaxBackups: viper.GetInt("LOG_MAX_BACKUPS"),
+		Retention:  viper.GetDuration("LOG_RETENTION"),
+
+		DisableRedaction: viper.GetBool("LOG_REDACT_DISABLED"),
+		RedactRules:      redactRules,
+		MaxValueLength:   viper.GetInt("LOG_MAX_VALUE_LENGTH"),
+	}, nil
+}
+
+// logLevelControl lets the client change the server's own log level with
+// MCP logging/setLevel requests, returning the server options that enable
+// them. The level is shared by every session, so it is only offered over
+// stdio, where the server has a single client; on the network transports one
+// client must not change the logging of all the others.
+func logLevelControl(mode string, hooks *server.Hooks, level *slog.LevelVar, logger *slog.Logger) []server.ServerOption {
+	if mode != constants.TransportStdio {
+		return nil
+	}
+
+	hooks.AddAfterSetLevel(func(ctx context.Context, _ any, req *mcp.SetLevelRequest, _ *mcp.EmptyResult) {
+		logger.InfoContext(ctx, "Log level changed by client", slog.String("level", string(req.Params.Level)))
+		level.Set(logs.MCPLevel(string(req.Params.Level)))
+	})
+
+	return []server.ServerOption{server.WithLogging()}
+}
+
+// transportConfig builds the transport configuration from flags, env and config file.
+func transportConfig() (transport.Config, error) {
+	mode, err := transport.ParseMode(viper.GetString("TRANSPORT"))
+	if err != nil {
+		return transport.Config{}, err
+	}
+
+	return transport.Config{
+		Mode:            mode,
+		Addr:            viper.GetString("LISTEN_ADDR"),
+		BaseURL:         viper.GetString("PUBLIC_BASE_URL"),
+		ShutdownTimeout: viper.GetDuration("SHUTDOWN_TIMEOUT"),
+	}, nil
+}
+
+// clientOptions builds the Tazapay API client options from config.
+func clientOptions() []api.Option {
+	viper.SetDefault("HTTP_TIMEOUT", constants.DefaultHTTPTimeout)
+	viper.SetDefault("HTTP_MAX_RETRIES", constants.DefaultRetryAttempts-1)
+	viper.SetDefault("HTTP_RETRY_INITIAL_BACKOFF", constants.DefaultRetryInitialBackoff)
+	viper.SetDefault("HTTP_RETRY_MAX_BACKOFF", constants.DefaultRetryMaxBackoff)
+
+	return []api.Option{
+		api.WithHTTPClient(&http.Client{Timeout: viper.GetDuration("HTTP_TIMEOUT")}),
+		api.WithRetryPolicy(api.RetryPolicy{
+			MaxAttempts:    viper.GetInt("HTTP_MAX_RETRIES") + 1,
+			InitialBackoff: viper.GetDuration("HTTP_RETRY_INITIAL_BACKOFF"),
+			MaxBackoff:     viper.GetDuration("HTTP_RETRY_MAX_BACKOFF"),
+		}),
+	}
+}
+
+// authConfig sets up inbound authentication for the network transports.
+// Static API tokens and OAuth access tokens can be combined; at least one is
+// required unless AUTH_DISABLED is set explicitly.
+func authConfig(cfg *transport.Config) error {
+	if cfg.Mode == constants.TransportStdio || viper.GetBool("AUTH_DISABLED") {
+		return nil
+	}
+
+	var chain auth.Chain
+
+	tokens, err := auth.NewStaticTokens(utils.GetStringList("AUTH_TOKENS"))
+	if err != nil {
+		return err
+	}
+
+	if tokens.Len() > 0 {
+		chain = append(chain, tokens)
+	}
+
+	if jwksFile := viper.GetString("AUTH_JWKS_FILE"); jwksFile != "" {
+		// Tokens must be issued for this server: the audience defaults to the
+		// public /mcp URL and the issuer to the only authorization server
+		resource := viper.GetString("AUTH_AUDIENCE")
+		if resource == "" && cfg.BaseURL != "" {
+			resource = strings.TrimRight(cfg.BaseURL, "/") + constants.MCPEndpointPath
+		}
+
+		servers := utils.GetStringList("AUTH_AUTHORIZATION_SERVERS")
+
+		issuer := viper.GetString("AUTH_ISSUER")
+		if issuer == "" && len(servers) == 1 {
+			issuer = servers[0]
+		}
+
+		validator, err := auth.NewJWTValidator(auth.OAuthConfig{
+			JWKSFile: jwksFile,
+			Issuer:   issuer,
+			Audience: resource,
+			Leeway:   time.Minute,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to load OAuth configuration: %w", err)
+		}
+
+		chain = append(chain, validator)
+
+		cfg.AuthMetadata = &auth.ResourceMetadata{
+			Resource:             resource,
+			AuthorizationServers: servers,
+			ScopesSupported:      registry.Scopes(),
+		}
+	}
+
+	if len(chain) == 0 {
+		return constants.ErrAuthNotConfigured
+	}
+
+	cfg.Auth = chain
+
+	return nil
+}
+
+// confirmGate builds the confirmation gate. CONFIRM_TOOLS lists the tools that
+// need user confirmation ("none" disables the gate); CONFIRM_TTL sets how long
+// a preview's confirmation token stays valid. Unknown tool names are rejected,
+// so a typo cannot silently remove the gate from a tool.
+func confirmGate(s *server.MCPServer, logger *slog.Logger) (*confirm.Gate, error) {
+	viper.SetDefault("CONFIRM_TOOLS", constants.DefaultConfirmTools)
+	viper.SetDefault("CONFIRM_TTL", constants.DefaultConfirmTTL)
+
+	tools := utils.GetStringList("CONFIRM_TOOLS")
+	if slices.Contains(tools, constants.ConfirmNone) {
+		tools = nil
+	}
+
+	for _, name := range tools {
+		if _, ok := registry.Lookup(name); !ok {
+			return nil, fmt.Errorf("%w: %q", constants.ErrUnknownConfirmTool, name)
+		}
+	}
+
+	logger.Info("Confirmation gate configured", slog.Any("tools", tools), slog.Duration("ttl", viper.GetDuration("CONFIRM_TTL")))
+
+	return confirm.NewGate(s, confirm.NewStore(viper.GetDuration("CONFIRM_TTL")), tools, logger), nil
+}
+
+// toolFilter selects the tools to expose. TOOLS_ENABLED and TOOLS_DISABLED
+// list tool names or categories; READ_ONLY drops every tool that creates or
+// changes Tazapay objects, e.g. for support staff.
+func toolFilter() registry.Filter {
+	return registry.Filter{
+		Enabled:  utils.GetStringList("TOOLS_ENABLED"),
+		Disabled: utils.GetStringList("TOOLS_DISABLED"),
+		ReadOnly: viper.GetBool("READ_ONLY"),
+	}
+}
+
+// resourceWatcher builds the watcher that notifies sessions when a resource
+// they subscribed to or read changes, answers their subscription requests
+// through the transport, and forgets sessions through hooks when they end.
+// RESOURCE_POLL_INTERVAL sets how often resources are checked; 0 disables
+// polling, leaving only the changes reported by webhooks.
+func resourceWatcher(s *server.MCPServer, hooks *server.Hooks, cfg *transport.Config, logger *slog.Logger,
+) *resources.Watcher {
+	viper.SetDefault("RESOURCE_POLL_INTERVAL", constants.DefaultResourcePollInterval)
+
+	interval := viper.GetDuration("RESOURCE_POLL_INTERVAL")
+	if interval <= 0 {
+		logger.Info("Resource polling disabled")
+	}
+
+	watcher := resources.NewWatcher(s, logger, interval)
+	hooks.AddOnUnregisterSession(watcher.Forget)
+
+	cfg.Intercept = &transport.Interceptor{
+		Methods: []string{constants.MethodResourcesSubscribe, constants.MethodResourcesUnsubscribe},
+		Handle:  watcher.Subscribe,
+	}
+
+	return watcher
+}
+
+// openStore opens the store that records tool calls, created objects and
+// webhook events. STORE_BACKEND is "memory" (the default), which keeps the
+// latest STORE_MEMORY_LIMIT records of each kind until restart, or "sqlite",
+// which keeps everything in the database file STORE_PATH.
+func openStore(ctx context.Context, logger *slog.Logger) (store.Store, error) {
+	viper.SetDefault("STORE_BACKEND", constants.StoreMemory)
+	viper.SetDefault("STORE_MEMORY_LIMIT", constants.DefaultMemoryStoreLimit)
+
+	switch backend := strings.ToLower(viper.GetString("STORE_BACKEND")); backend {
+	case constants.StoreMemory:
+		logger.Info("Using in-memory store", slog.Int("limit", viper.GetInt("STORE_MEMORY_LIMIT")))
+		return store.NewMemory(viper.GetInt("STORE_MEMORY_LIMIT")), nil
+
+	case constants.StoreSQLite:
+		path := viper.GetString("STORE_PATH")
+		if path == "" {
+			home, err := os.UserHomeDir()
+			if err != nil {
+				return nil, fmt.Errorf("failed to locate the store, set STORE_PATH: %w", err)
+			}
+
+			path = filepath.Join(home, constants.DefaultStorePath)
+		}
+
+		logger.Info("Using SQLite store", slog.String("path", path))
+
+		return store.OpenSQLite(ctx, path)
+
+	default:
+		return nil, fmt.Errorf("%w: %q", constants.ErrUnknownStoreBackend, backend)
+	}
+}
+
+// eventStore returns where received webhook events are kept, or nil when no
+// WEBHOOK_SECRET is set and the webhook receiver is off.
+func eventStore(s store.Store) store.EventStore {
+	if viper.GetString("WEBHOOK_SECRET") == "" {
+		return nil
+	}
+
+	return s
+}
+
+// webhookConfig reads how deliveries to the webhook receiver are verified:
+// WEBHOOK_SECRET, WEBHOOK_TOLERANCE and the signing scheme in
+// WEBHOOK_SIGNATURE_HEADER, WEBHOOK_TIMESTAMP_HEADER and
+// WEBHOOK_SIGNATURE_ENCODING. An empty timestamp header means only the body
+// is signed. Events are stored under the name of env.
+func webhookConfig(env types.Environment) (webhook.Config, error) {
+	scheme := webhook.DefaultScheme()
+
+	viper.SetDefault("WEBHOOK_TOLERANCE", constants.DefaultWebhookTolerance)
+	viper.SetDefault("WEBHOOK_SIGNATURE_HEADER", scheme.SignatureHeader)
+	viper.SetDefault("WEBHOOK_TIMESTAMP_HEADER", scheme.TimestampHeader)
+	viper.SetDefault("WEBHOOK_SIGNATURE_ENCODING", scheme.Encoding)
+
+	scheme = webhook.Scheme{
+		SignatureHeader: viper.GetString("WEBHOOK_SIGNATURE_HEADER"),
+		TimestampHeader: viper.GetString("WEBHOOK_TIMESTAMP_HEADER"),
+		Encoding:        strings.ToLower(strings.TrimSpace(viper.GetString("WEBHOOK_SIGNATURE_ENCODING"))),
+	}
+
+	if err := scheme.Validate(); err != nil {
+		return webhook.Config{}, err
+	}
+
+	return webhook.Config{
+		Secret:      []byte(viper.GetString("WEBHOOK_SECRET")),
+		Scheme:      scheme,
+		Tolerance:   viper.GetDuration("WEBHOOK_TOLERANCE"),
+		Environment: env.Name,
+	}, nil
+}
+
+// serveWebhooks receives webhooks on WEBHOOK_LISTEN_ADDR and WEBHOOK_PATH
+// until ctx is cancelled, refreshing the resources each event may have
+// changed in the background, so deliveries are acknowledged right away.
+func serveWebhooks(ctx context.Context, cfg webhook.Config, events store.EventStore, watcher *resources.Watcher,
+	logger *slog.Logger,
+) error {
+	viper.SetDefault("WEBHOOK_LISTEN_ADDR", constants.DefaultWebhookListenAddr)
+	viper.SetDefault("WEBHOOK_PATH", constants.DefaultWebhookPath)
+
+	onEvent := func(e types.Event) {
+		watcher.RefreshLater(ctx, resources.AffectedBy(e))
+	}
+
+	handler := webhook.NewHandler(cfg, events, onEvent, logger)
+
+	err := webhook.Serve(ctx, viper.GetString("WEBHOOK_LISTEN_ADDR"), viper.GetString("WEBHOOK_PATH"), handler,
+		logger, viper.GetDuration("SHUTDOWN_TIMEOUT"))
+
+	// The refreshes the webhooks started read the store too
+	watcher.Wait()
+
+	return err
+}
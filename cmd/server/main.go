@@ -4,8 +4,12 @@ import (
 	"context"
 	"encoding/base64"
 	"errors"
+	"fmt"
 	"log/slog"
 	"os"
+	"os/signal"
+	"sync"
+	"syscall"
 
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/spf13/viper"
@@ -13,75 +17,207 @@ import (
 	"github.com/tazapay/tazapay-mcp-server/constants"
 
 	logs "github.com/tazapay/tazapay-mcp-server/pkg/logs"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/transport"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/pkg/webhook"
+	"github.com/tazapay/tazapay-mcp-server/prompts"
+	"github.com/tazapay/tazapay-mcp-server/resources"
 	tools "github.com/tazapay/tazapay-mcp-server/tools/register"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
 )
 
-func initConfig(logger *slog.Logger) error {
+// readConfig reads the environment and the optional config file in the home directory.
+func readConfig() error {
 	viper.AutomaticEnv()
 
 	home, err := os.UserHomeDir()
-	if err == nil {
-		viper.AddConfigPath(home)
-		viper.SetConfigName(".tazapay-mcp-server")
-		viper.SetConfigType("yaml")
-
-		readErr := viper.ReadInConfig()
-		if readErr != nil {
-			var notFoundErr viper.ConfigFileNotFoundError
-			if !errors.As(readErr, &notFoundErr) {
-				logger.Error("Config read error", "error", readErr)
-				return readErr
-			}
+	if err != nil {
+		return nil
+	}
+
+	viper.AddConfigPath(home)
+	viper.SetConfigName(".tazapay-mcp-server")
+	viper.SetConfigType("yaml")
+
+	if err := viper.ReadInConfig(); err != nil {
+		var notFoundErr viper.ConfigFileNotFoundError
+		if !errors.As(err, &notFoundErr) {
+			return err
 		}
 	}
 
+	return nil
+}
+
+// initConfig resolves the Tazapay credentials and environment from the config read by readConfig.
+func initConfig(logger *slog.Logger) (types.Environment, error) {
 	accessKey := viper.GetString("TAZAPAY_API_KEY")
 	secretKey := viper.GetString("TAZAPAY_API_SECRET")
 
 	if accessKey == "" || secretKey == "" {
 		logger.Error("Missing API credentials")
-		return constants.ErrMissingAuthKeys
+		return types.Environment{}, constants.ErrMissingAuthKeys
+	}
+
+	env, err := utils.ResolveEnvironment(viper.GetString("TAZAPAY_ENVIRONMENT"))
+	if err != nil {
+		logger.Error("Invalid environment", "error", err)
+		return types.Environment{}, err
 	}
 
 	authString := accessKey + ":" + secretKey
 	authToken := base64.StdEncoding.EncodeToString([]byte(authString))
 	viper.Set("TAZAPAY_AUTH_TOKEN", authToken)
 
-	logger.Info("Configuration initialized")
+	logger.Info("Configuration initialized", "environment", env.Name, "base_url", env.BaseURL)
 
-	return nil
+	return env, nil
 }
 
 func main() {
-	// Create a logger configuration
-	logConfig := logs.Config{
-		Level:    "info",                           // Example log level
-		Format:   "json",                           // Example log format
-		FilePath: viper.GetString("LOG_FILE_PATH"), // Optional file path for logs, if needed
+	os.Exit(run())
+}
+
+// run runs the server, or the audit subcommand, and returns the process exit
+// code. Returning instead of exiting lets the deferred cleanups run.
+func run() int {
+	if len(os.Args) > 1 && os.Args[1] == auditCommand {
+		return runAudit(os.Args[2:], os.Stdout, os.Stderr)
 	}
 
-	// Create the logger
-	logger, cleanup, err := logs.New(logConfig) // Empty path = default path near binary
-	defer cleanup(context.Background())
+	bindFlags()
 
-	// Exit if logger failed
+	// The logger is configured from the environment and config file too
+	if err := readConfig(); err != nil {
+		fmt.Fprintln(os.Stderr, "failed to read config:", err)
+		return 1
+	}
+
+	level := new(slog.LevelVar)
+
+	logCfg, err := logConfig(level)
 	if err != nil {
-		os.Exit(1)
+		fmt.Fprintln(os.Stderr, "invalid log configuration:", err)
+		return 1
 	}
 
-	if err := initConfig(logger); err != nil {
+	// Create the logger; an unusable log file falls back to stderr
+	logger, cleanup := logs.New(logCfg) // Empty path = default path near binary
+	defer cleanup(context.Background())
+
+	env, err := initConfig(logger)
+	if err != nil {
 		logger.Error("failed to initialize config", "error", err)
-		os.Exit(1)
+		return 1
 	}
 
-	s := server.NewMCPServer("tazapay", "0.0.1")
+	transportCfg, err := transportConfig()
+	if err != nil {
+		logger.Error("failed to initialize transport", "error", err)
+		return 1
+	}
 
-	tools.RegisterTools(s, logger)
+	if err := authConfig(&transportCfg); err != nil {
+		logger.Error("failed to initialize authentication", "error", err)
+		return 1
+	}
+
+	// Subscription requests are answered by the transport, which SSE does not allow
+	subscribe := transportCfg.Mode != constants.TransportSSE
+
+	hooks := &server.Hooks{}
+	opts := append([]server.ServerOption{
+		server.WithElicitation(), server.WithResourceCapabilities(subscribe, true), server.WithPromptCapabilities(false),
+		server.WithHooks(hooks),
+	}, logLevelControl(transportCfg.Mode, hooks, level, logger)...)
+
+	s := server.NewMCPServer("tazapay", "0.0.1", opts...)
 
-	logger.Info("Started Tazapay MCP Server.")
+	client := api.NewClient(env, viper.GetString("TAZAPAY_AUTH_TOKEN"), logger, clientOptions()...)
+
+	st, err := openStore(context.Background(), logger)
+	if err != nil {
+		logger.Error("failed to open store", "error", err)
+		return 1
+	}
 
-	if err := server.ServeStdio(s); err != nil {
+	defer st.Close()
+
+	auditLog, err := openAuditLog(logger)
+	if err != nil {
+		logger.Error("failed to open audit log", "error", err)
+		return 1
+	}
+
+	if auditLog != nil {
+		defer auditLog.Close()
+	}
+
+	filter := toolFilter()
+	events := eventStore(st)
+	deps := registry.Deps{Logger: logger, Client: client, Store: st, Events: events, Audit: auditLog}
+
+	var webhookCfg webhook.Config
+
+	if events != nil {
+		if webhookCfg, err = webhookConfig(env); err != nil {
+			logger.Error("failed to configure the webhook receiver", "error", err)
+			return 1
+		}
+	}
+
+	gate, err := confirmGate(s, logger)
+	if err != nil {
+		logger.Error("failed to configure confirmation", "error", err)
+		return 1
+	}
+
+	if err := tools.RegisterTools(s, deps, gate, filter); err != nil {
+		logger.Error("failed to register tools", "error", err)
+		return 1
+	}
+
+	prompts.Register(s, logger, filter)
+
+	watcher := resourceWatcher(s, hooks, &transportCfg, logger)
+	resources.New(logger, client, watcher, events).Register(s)
+
+	logger.Info("Started Tazapay MCP Server.", "transport", transportCfg.Mode)
+
+	// Deferred in this order, the background work is cancelled, then waited
+	// for before the store and the audit log are closed under it: stdio
+	// returns on EOF without a signal
+	var background sync.WaitGroup
+	defer background.Wait()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	background.Add(1)
+
+	go func() {
+		defer background.Done()
+		watcher.Run(ctx)
+	}()
+
+	if events != nil {
+		background.Add(1)
+
+		go func() {
+			defer background.Done()
+
+			if err := serveWebhooks(ctx, webhookCfg, events, watcher, logger); err != nil {
+				logger.Error("webhook receiver stopped", "error", err)
+			}
+		}()
+	}
+
+	if err := transport.Serve(ctx, s, transportCfg, logger); err != nil {
 		logger.Error("server exited with error", "error", err)
-		os.Exit(1)
+		return 1
 	}
+
+	return 0
 }
@@ -0,0 +1,13 @@
+package constants
+
+// Audit log
+const (
+	// DefaultAuditLogPath is the audit log file, relative to the home directory
+	DefaultAuditLogPath = ".tazapay-mcp-server.audit.jsonl"
+
+	// MaxAuditLineBytes bounds the size of one audit log entry when reading the log
+	MaxAuditLineBytes = 1 << 20
+
+	// Redacted replaces argument values that are personal data
+	Redacted = "[redacted]"
+)
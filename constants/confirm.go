@@ -0,0 +1,22 @@
+package constants
+
+import "time"
+
+// Confirmation gate constants
+const (
+	ConfirmationTokenField = "confirmation_token"
+	ConfirmationTokenDesc  = "Token returned by the preview of this call. Only send it after the user" +
+		" has confirmed the preview; the arguments must be unchanged"
+
+	ConfirmField = "confirm"
+
+	DefaultConfirmTTL  = 5 * time.Minute
+	ConfirmTokenBytes  = 12
+	ConfirmTokenPrefix = "cnf_"
+
+	// ConfirmNone disables the confirmation gate when given as CONFIRM_TOOLS
+	ConfirmNone = "none"
+)
+
+// DefaultConfirmTools lists the tools that need confirmation unless CONFIRM_TOOLS is set.
+var DefaultConfirmTools = []string{CreateRefundToolName, CreatePayoutToolName}
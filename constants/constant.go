@@ -1,9 +1,11 @@
 package constants
 
 const (
-	Num100       = 100
 	Error        = "error"
 	OpenFileMode = 0o666
 	Num64        = 64
 	Num2         = 2
+	Num3         = 3
+	Num7         = 7
+	Num8         = 8
 )
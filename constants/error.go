@@ -12,4 +12,93 @@ var (
 		"TAZAPAY_API_KEY or TAZAPAY_API_SECRET not set. Use -e option or provide a " +
 			"`.tazapay-mcp-server.yaml` config file in your home directory",
 	)
+	ErrInvalidEnvironment = errors.New(
+		"invalid TAZAPAY_ENVIRONMENT: use \"production\", \"sandbox\" or an http(s) base URL",
+	)
+	ErrInvalidTransport    = errors.New("invalid transport: use \"stdio\", \"sse\" or \"http\"")
+	ErrUnknownStoreBackend = errors.New("invalid STORE_BACKEND: use \"memory\" or \"sqlite\"")
+	ErrInvalidRedactRule   = errors.New("invalid log redaction rule: use key:action with action drop, redact," +
+		" mask_email, hash, last4 or truncate")
+	ErrInvalidLogLevel   = errors.New("invalid LOG_LEVEL: use \"debug\", \"info\", \"warn\" or \"error\"")
+	ErrInvalidLogFormat  = errors.New("invalid LOG_FORMAT: use \"json\" or \"text\"")
+	ErrAuthNotConfigured = errors.New(
+		"network transports require AUTH_TOKENS or AUTH_JWKS_FILE; set AUTH_DISABLED=true to run without authentication",
+	)
+	ErrMissingAuthAudience = errors.New("AUTH_JWKS_FILE requires AUTH_AUDIENCE or PUBLIC_BASE_URL," +
+		" so tokens issued for other resources are rejected")
+	ErrMissingAuthIssuer = errors.New("AUTH_JWKS_FILE requires AUTH_ISSUER or a single AUTH_AUTHORIZATION_SERVERS" +
+		" entry, so tokens from other issuers are rejected")
+)
+
+// Tool argument errors
+var (
+	ErrInvalidArguments       = errors.New("invalid arguments")
+	ErrMissingRefundTarget    = errors.New("either payin or checkout is required")
+	ErrMissingRefundCurrency  = errors.New("currency is required for a partial refund")
+	ErrNonPositiveAmount      = errors.New("amount must be greater than zero")
+	ErrInvalidAmount          = errors.New("invalid amount")
+	ErrAmountPrecision        = errors.New("too many decimal places")
+	ErrInvalidFixedSide       = errors.New("fixed_side must be \"source\" or \"destination\"")
+	ErrMissingRequiredField   = errors.New("missing required field")
+	ErrInvalidBeneficiaryType = errors.New("type must be \"individual\" or \"business\"")
+	ErrInvalidDestinationType = errors.New(
+		"destination_type must be \"bank\", \"wallet\" or \"local_payment_network\"",
+	)
+	ErrMissingCheckoutLookup = errors.New("either checkout_id or reference_id is required")
+	ErrCheckoutNotFound      = errors.New("no checkout found")
+	ErrMissingBankAccount    = errors.New("account_number or an iban in bank_codes is required for bank destinations")
+	ErrMissingCustomer       = errors.New(
+		"either customer or customer_name, customer_email and customer_country are required",
+	)
+	ErrNoCustomerChanges = errors.New("no fields to update")
+)
+
+// Webhook errors
+var (
+	ErrInvalidSignature       = errors.New("invalid webhook signature")
+	ErrStaleWebhook           = errors.New("webhook timestamp outside the allowed tolerance")
+	ErrInvalidWebhookBody     = errors.New("invalid webhook body")
+	ErrInvalidWebhookEncoding = errors.New("invalid WEBHOOK_SIGNATURE_ENCODING: use \"hex\" or \"base64\"")
+)
+
+// Prompt errors
+var (
+	ErrMissingPromptArgument = errors.New("missing prompt argument")
+)
+
+// Confirmation errors
+var (
+	ErrConfirmationInvalid  = errors.New("unknown or already used confirmation token")
+	ErrConfirmationExpired  = errors.New("confirmation token expired; preview the call again")
+	ErrConfirmationMismatch = errors.New("confirmation token was issued for a different call; preview the call again")
+	ErrUnknownConfirmTool   = errors.New("unknown tool in CONFIRM_TOOLS")
+)
+
+// Inbound authentication errors
+var (
+	ErrMissingBearerToken   = errors.New("missing bearer token")
+	ErrInvalidToken         = errors.New("invalid token")
+	ErrTokenExpired         = errors.New("token expired")
+	ErrTokenNotYetValid     = errors.New("token not yet valid")
+	ErrInvalidIssuer        = errors.New("invalid token issuer")
+	ErrInvalidAudience      = errors.New("invalid token audience")
+	ErrUnknownSigningKey    = errors.New("unknown signing key")
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+	ErrInvalidJWKS          = errors.New("invalid JWKS")
+	ErrInvalidStaticToken   = errors.New(`AUTH_TOKENS entries must be "name:token" or "token" with a non-empty name and token`)
+	ErrInsufficientScope    = errors.New("token does not grant the scope required by this tool")
+)
+
+// Tool registry errors
+var (
+	ErrUnknownTool   = errors.New("unknown tool or category")
+	ErrDuplicateTool = errors.New("tool registered twice")
+	ErrNoToolsLeft   = errors.New("no tools left to register; check TOOLS_ENABLED, TOOLS_DISABLED and READ_ONLY")
+)
+
+// Audit log errors
+var (
+	ErrAuditChainBroken   = errors.New("audit log chain is broken")
+	ErrInvalidAuditEntry  = errors.New("invalid audit log entry")
+	ErrAuditEntryTooLarge = errors.New("audit log entry too large")
 )
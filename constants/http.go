@@ -1,5 +1,7 @@
 package constants
 
+import "time"
+
 const (
 	HeaderAccept        = "Accept"
 	HeaderAuthorization = "Authorization"
@@ -12,3 +14,18 @@ const (
 	HTTPStatusOKMin = 200
 	HTTPStatusOKMax = 300
 )
+
+// MaxErrorBodyLength caps how much of an unparseable error body is kept
+const MaxErrorBodyLength = 512
+
+// Retry and idempotency settings
+const (
+	HeaderRetryAfter     = "Retry-After"
+	HeaderIdempotencyKey = "Idempotency-Key"
+
+	DefaultHTTPTimeout         = 30 * time.Second
+	DefaultRetryAttempts       = 3
+	DefaultRetryInitialBackoff = 200 * time.Millisecond
+	DefaultRetryMaxBackoff     = 5 * time.Second
+	IdempotencyKeyBytes        = 16
+)
@@ -0,0 +1,40 @@
+package constants
+
+import "time"
+
+// Log defaults
+const (
+	DefaultLogLevel  = "info"
+	DefaultLogFormat = "json"
+
+	// DefaultLogMaxSizeMB is the size at which the log file is rotated
+	DefaultLogMaxSizeMB = 100
+
+	// DefaultLogMaxBackups is how many rotated log files are kept
+	DefaultLogMaxBackups = 10
+
+	// DefaultLogRetention is how long rotated log files are kept
+	DefaultLogRetention = 30 * 24 * time.Hour
+
+	// LogBackupTimeFormat stamps rotated log files, e.g. tazapay-mcp-server-20250131T090000.000.log
+	LogBackupTimeFormat = "20060102T150405.000"
+)
+
+// Log redaction actions
+const (
+	RedactDrop      = "drop"       // Remove the attribute
+	RedactMask      = "redact"     // Replace the value with Redacted
+	RedactMaskEmail = "mask_email" // Keep the first letter and the domain
+	RedactHash      = "hash"       // Replace the value with a short hash, so equal values can be correlated
+	RedactLastFour  = "last4"      // Keep the last four characters
+	RedactTruncate  = "truncate"   // Cut the value to LogTruncateLength
+)
+
+// Log redaction limits
+const (
+	// LogTruncateLength is the length kept of values with the truncate action, e.g. HTTP bodies
+	LogTruncateLength = 256
+
+	// DefaultLogMaxValueLength caps the length of every logged string
+	DefaultLogMaxValueLength = 2048
+)
@@ -4,6 +4,16 @@ package constants
 const (
 	// Production
 	ProdBaseURL = "https://service.tazapay.com/v3"
+
+	// Sandbox
+	SandboxBaseURL = "https://service-sandbox.tazapay.com/v3"
+)
+
+// Environment names
+const (
+	EnvProduction = "production"
+	EnvSandbox    = "sandbox"
+	EnvCustom     = "custom"
 )
 
 // API Path Segments
@@ -11,13 +21,13 @@ const (
 	CheckoutPath = "/checkout"
 	FxPayoutPath = "/fx/payout"
 	BalancePath  = "/balance"
-)
+	RefundPath   = "/refund"
+	PayoutPath   = "/payout"
 
-// Production URLs
-const (
-	PaymentLinkBaseURLProd = ProdBaseURL + CheckoutPath
-	PaymentFxBaseURLProd   = ProdBaseURL + FxPayoutPath
-	BalanceBaseURLProd     = ProdBaseURL + BalancePath
+	BeneficiaryPath    = "/beneficiary"
+	CustomerPath       = "/customer"
+	PayinPath          = "/payin"
+	PaymentAttemptPath = "/payment_attempt"
 )
 
 // HTTP Method Constants
@@ -27,3 +37,9 @@ const (
 	PutHTTPMethod    = "PUT"
 	DeleteHTTPMethod = "DELETE"
 )
+
+// DefaultCurrencyExponent is the number of minor-unit digits assumed for unknown currencies
+const DefaultCurrencyExponent = 2
+
+// MaxAmountMinorUnits bounds tool amounts to catch misplaced decimals and unit mix-ups
+const MaxAmountMinorUnits = 1_000_000_000_000
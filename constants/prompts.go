@@ -0,0 +1,48 @@
+package constants
+
+// Prompt names and descriptions
+const (
+	CollectPaymentPromptName = "collect_payment"
+	CollectPaymentPromptDesc = "Collect a payment from a customer with a payment link and track whether it was paid"
+
+	ReconcilePaymentsPromptName = "reconcile_payments"
+	ReconcilePaymentsPromptDesc = "Reconcile the checkouts and refunds of a day against the account balances"
+
+	QuotePayoutPromptName = "quote_and_send_payout"
+	QuotePayoutPromptDesc = "Quote a payout to a beneficiary, confirm the FX rate and debit, then send it"
+
+	InvestigatePaymentPromptName = "investigate_failed_payment"
+	InvestigatePaymentPromptDesc = "Find out why a customer's payment failed and what they can do about it"
+)
+
+// Prompt arguments
+const (
+	PromptCustomerNameArg     = "customer_name"
+	PromptCustomerNameDesc    = "Full name of the customer"
+	PromptCustomerEmailArg    = "customer_email"
+	PromptCustomerEmailDesc   = "Email address of the customer"
+	PromptCustomerCountryArg  = "customer_country"
+	PromptCustomerCountryDesc = "Country of the customer; asked for when omitted"
+	PromptAmountArg           = "amount"
+	PromptAmountDesc          = "Amount in major units, e.g. 10.50"
+	PromptCurrencyArg         = "currency"
+	PromptCurrencyDesc        = "ISO 4217 currency code, e.g. USD"
+	PromptDescriptionArg      = "description"
+	PromptDescriptionDesc     = "What the payment is for"
+
+	PromptDateArg            = "date"
+	PromptDateDesc           = "Day to reconcile as YYYY-MM-DD; defaults to today (UTC)"
+	PromptCurrencyFilterDesc = "Only reconcile this currency"
+
+	PromptBeneficiaryArg      = "beneficiary"
+	PromptBeneficiaryDesc     = "Beneficiary ID or name"
+	PromptHoldingCurrencyArg  = "holding_currency"
+	PromptHoldingCurrencyDesc = "Balance that funds the payout; defaults to the payout currency"
+	PromptPurposeArg          = "purpose"
+	PromptPurposeDesc         = "Purpose of the payout"
+
+	PromptCheckoutIDArg   = "checkout_id"
+	PromptCheckoutIDDesc  = "Checkout ID of the failed payment"
+	PromptReferenceIDArg  = "reference_id"
+	PromptReferenceIDDesc = "Merchant reference of the failed payment, if the checkout ID is unknown"
+)
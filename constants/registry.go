@@ -0,0 +1,23 @@
+package constants
+
+// Tool categories. TOOLS_ENABLED and TOOLS_DISABLED accept them in place of tool names
+const (
+	CategoryFX            = "fx"
+	CategoryCheckouts     = "checkouts"
+	CategoryBalances      = "balances"
+	CategoryRefunds       = "refunds"
+	CategoryBeneficiaries = "beneficiaries"
+	CategoryPayouts       = "payouts"
+	CategoryCustomers     = "customers"
+	CategoryPayins        = "payins"
+	CategoryEvents        = "events"
+	CategoryHistory       = "history"
+)
+
+// OAuth scopes required to call tools over an OAuth-authenticated transport
+const (
+	ScopePaymentsRead  = "payments:read"
+	ScopePaymentsWrite = "payments:write"
+	ScopePayoutsRead   = "payouts:read"
+	ScopePayoutsWrite  = "payouts:write"
+)
@@ -0,0 +1,40 @@
+package constants
+
+import "time"
+
+// MCP resources
+const (
+	BalanceResourceURI  = "tazapay://balance"
+	BalanceResourceName = "Account balances"
+	BalanceResourceDesc = "Available balances of the merchant account in every currency. Amounts are in minor units."
+
+	CurrencyBalanceResourceTemplate = "tazapay://balance/{currency}"
+	CurrencyBalanceResourceName     = "Balance in one currency"
+	CurrencyBalanceResourceDesc     = "Available balance in a single ISO 4217 currency, e.g. tazapay://balance/USD"
+
+	RecentCheckoutsResourceURI  = "tazapay://checkouts"
+	RecentCheckoutsResourceName = "Recent checkouts"
+	RecentCheckoutsResourceDesc = "The most recent checkouts with their payment status and amount paid"
+
+	CheckoutResourceTemplate = "tazapay://checkouts/{id}"
+	CheckoutResourceName     = "Checkout"
+	CheckoutResourceDesc     = "A single checkout with its payment status, amount paid and payment attempts"
+
+	FXResourceTemplate = "tazapay://fx/{from}/{to}"
+	FXResourceName     = "FX rate"
+	FXResourceDesc     = "Current payout FX rate for one unit of from, e.g. tazapay://fx/USD/INR"
+
+	ResourceCurrencyArg = "currency"
+	ResourceIDArg       = "id"
+	ResourceFromArg     = "from"
+	ResourceToArg       = "to"
+
+	ResourceMIMEType = "application/json"
+
+	// Subscription requests, which the MCP library does not route itself
+	MethodResourcesSubscribe   = "resources/subscribe"
+	MethodResourcesUnsubscribe = "resources/unsubscribe"
+
+	// DefaultResourcePollInterval is how often resources that clients have read are checked for changes
+	DefaultResourcePollInterval = time.Minute
+)
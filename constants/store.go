@@ -0,0 +1,52 @@
+package constants
+
+// Store backends
+const (
+	StoreMemory = "memory"
+	StoreSQLite = "sqlite"
+
+	// DefaultStorePath is the SQLite database file, relative to the home directory
+	DefaultStorePath = ".tazapay-mcp-server.db"
+
+	// DefaultMemoryStoreLimit is how many calls, objects and events the memory store keeps of each
+	DefaultMemoryStoreLimit = 1000
+)
+
+// Outcomes of a recorded tool call
+const (
+	OutcomeOK                   = "ok"
+	OutcomeConfirmationRequired = "confirmation_required"
+	OutcomeCancelled            = "cancelled"
+	OutcomeError                = "error"
+)
+
+// Kinds of stored objects
+const (
+	ObjectCheckout    = "checkout"
+	ObjectRefund      = "refund"
+	ObjectPayout      = "payout"
+	ObjectBeneficiary = "beneficiary"
+	ObjectCustomer    = "customer"
+	ObjectFXQuote     = "fx_quote"
+)
+
+// History tools
+const (
+	ListCreatedObjectsToolName = "tazapay_list_created_objects_tool"
+	ListCreatedObjectsToolDesc = "Lists the checkouts, refunds, payouts, beneficiaries, customers and FX quotes created" +
+		" through this server, newest first, from the local store without calling the Tazapay API." +
+		" Answers questions like \"which payment links did I create this week\""
+
+	ListToolCallsToolName = "tazapay_list_tool_calls_tool"
+	ListToolCallsToolDesc = "Lists the tool calls made to this server, newest first, with their outcome and the object" +
+		" they created"
+
+	ObjectKindField = "kind"
+	ObjectKindDesc  = "Only list objects of this kind"
+
+	ToolNameField = "tool"
+	ToolNameDesc  = "Only list calls of this tool"
+
+	UntilField = "until"
+	UntilDesc  = "Only list records before this RFC 3339 time"
+)
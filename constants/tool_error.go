@@ -0,0 +1,42 @@
+package constants
+
+// Tool error codes, returned with every failed tool call so the model can
+// decide whether to fix its arguments, retry or ask the user for help
+const (
+	ErrCodeInvalidArguments   = "invalid_arguments"
+	ErrCodeConfirmation       = "confirmation_failed"
+	ErrCodeUnauthorized       = "tazapay_unauthorized"
+	ErrCodeForbidden          = "forbidden"
+	ErrCodeNotFound           = "not_found"
+	ErrCodeRejected           = "tazapay_rejected"
+	ErrCodeConflict           = "conflict"
+	ErrCodeRateLimited        = "rate_limited"
+	ErrCodeUnavailable        = "tazapay_unavailable"
+	ErrCodeTimeout            = "timeout"
+	ErrCodeCancelled          = "cancelled"
+	ErrCodeUnexpectedResponse = "unexpected_response"
+	ErrCodeInternal           = "internal_error"
+)
+
+// Remediation hints for each tool error code
+const (
+	HintInvalidArguments = "Correct the listed arguments and call the tool again. Ask the user if a value is unknown."
+	HintConfirmation     = "Call the tool again without confirmation_token to get a new preview and confirm it with the user."
+	HintUnauthorized     = "The server's Tazapay credentials were rejected. Ask the operator to check TAZAPAY_API_KEY," +
+		" TAZAPAY_API_SECRET and TAZAPAY_ENVIRONMENT; do not retry."
+	HintForbidden   = "The caller's token does not grant the scope this tool needs. Ask the operator for access; do not retry."
+	HintNotFound    = "Check the ID, or use a list tool to find the right one."
+	HintRejected    = "Tazapay rejected the request. Fix the problems it reported and call the tool again."
+	HintConflict    = "The object is not in a state that allows this. Fetch it to check its current status."
+	HintRateLimited = "Tazapay is rate limiting requests. Wait a little before retrying."
+	HintUnavailable = "Tazapay is temporarily unavailable. Retry later. A call that creates or changes something may" +
+		" already have taken effect: check with a get or list tool before calling it again."
+	HintTimeout = "The request timed out. A call that creates or changes something may already have taken effect:" +
+		" check with a get or list tool before calling it again. A read can simply be retried."
+	HintCancelled  = "The call was cancelled before it completed."
+	HintUnexpected = "Tazapay returned an unexpected response. Fetch the object to check whether the call took effect."
+	HintInternal   = "An unexpected error occurred. Do not retry; report the message to the user."
+)
+
+// ToolErrorMetaKey is the _meta key of the structured error attached to failed tool results
+const ToolErrorMetaKey = "tazapay/error"
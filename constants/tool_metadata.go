@@ -9,7 +9,7 @@ const (
 	InvoiceCurrencyDesc  = "Currency in which the invoice is to be raised (e.g., USD, EUR)"
 
 	PaymentAmountField = "payment_amount"
-	PaymentAmountDesc  = "Total invoice amount to be paid"
+	PaymentAmountDesc  = "Total invoice amount to be paid, in major units with at most the currency's decimal places (e.g. 10.50 USD, 1500 JPY)"
 
 	CustomerNameField = "customer_name"
 	CustomerNameDesc  = "Full name of the customer"
@@ -24,10 +24,61 @@ const (
 	TransactionDesc      = "Short description or purpose of the transaction"
 )
 
+// Optional checkout options of the payment link tool
+const (
+	PaymentLinkReferenceDesc = "Your own reference for the checkout, e.g. an order number. Checkouts can be looked up by it"
+
+	SuccessURLField = "success_url"
+	SuccessURLDesc  = "URL the customer is sent to after paying"
+
+	CancelURLField = "cancel_url"
+	CancelURLDesc  = "URL the customer is sent to after cancelling"
+
+	WebhookURLField = "webhook_url"
+	WebhookURLDesc  = "URL that receives status updates for this checkout"
+
+	ExpiresAtField = "expires_at"
+	ExpiresAtDesc  = "When the payment link expires, in RFC 3339 format (e.g. 2025-12-31T23:59:59Z)"
+
+	PaymentMethodsField = "payment_methods"
+	PaymentMethodsDesc  = "Payment methods to offer, e.g. [\"card\", \"paynow_sgd\"]; all enabled methods when omitted"
+
+	ItemsField = "items"
+	ItemsDesc  = "Line items shown on the checkout page. amount is the unit price in major units of the invoice currency;" +
+		" quantity times amount, summed over the items, must equal payment_amount"
+
+	ShippingAddressField = "shipping_address"
+	ShippingAddressDesc  = "Shipping address of the customer"
+
+	BillingAddressField = "billing_address"
+	BillingAddressDesc  = "Billing address of the customer"
+
+	MetadataField = "metadata"
+	MetadataDesc  = "Key-value pairs of strings stored with the checkout and returned in webhooks"
+
+	ItemNameKey        = "name"
+	ItemDescriptionKey = "description"
+	ItemQuantityKey    = "quantity"
+	ItemAmountKey      = "amount"
+
+	AddressNameKey       = "name"
+	AddressLine1Key      = "line1"
+	AddressLine2Key      = "line2"
+	AddressCityKey       = "city"
+	AddressStateKey      = "state"
+	AddressCountryKey    = "country"
+	AddressPostalCodeKey = "postal_code"
+
+	MaxCheckoutItems    = 100
+	MaxMetadataKeys     = 20
+	MaxMetadataValueLen = 500
+)
+
 // FX Tool constants
 const (
 	FXToolName        = "tazapay_fetch_fx_tool"
-	FXToolDescription = "Get FX rate from one currency to another using Tazapay FX rate"
+	FXToolDescription = "Get FX rate from one currency to another using Tazapay FX rate." +
+		" Returns the full-precision rate, the inverse rate and the quote timestamp"
 
 	FXFromField       = "from"
 	FXFromDescription = "Currency to convert from. It should be in 3 letter currency code. Example : USD, INR"
@@ -36,7 +87,18 @@ const (
 	FXToDescription = "Currency to convert to. It should be in 3 letter currency code. Example : USD, INR"
 
 	FXAmountField       = "amount"
-	FXAmountDescription = "Amount to convert. It should be a number and should not have any decimal places."
+	FXAmountDescription = "Amount to convert, in major units. Decimals are allowed up to the currency's" +
+		" minor unit, e.g. 99.99 USD. It is in the from currency unless fixed_side is \"destination\""
+
+	FXFixedSideField       = "fixed_side"
+	FXFixedSideDescription = "Which amount is fixed: \"source\" (default) converts amount of the from currency;" +
+		" \"destination\" finds how much of the from currency buys amount of the to currency"
+
+	FXFixedSource      = "source"
+	FXFixedDestination = "destination"
+
+	// FXInverseRateDigits is the number of significant digits shown for the derived inverse rate
+	FXInverseRateDigits = 10
 )
 
 // Balance Fetch tool
@@ -48,3 +110,232 @@ const (
 	BalanceCurrencyField = "currency"
 	BalanceCurrencyDesc  = "Currency to fetch balance for. It should be in 3 letter currency code. Example : USD, INR"
 )
+
+// Checkout lookup tools
+const (
+	GetCheckoutToolName = "tazapay_get_checkout_tool"
+	GetCheckoutToolDesc = "Fetches a checkout (payment link) by its ID or reference and reports whether it was paid," +
+		" the amount paid, payment attempts and expiry"
+
+	ListCheckoutsToolName = "tazapay_list_checkouts_tool"
+	ListCheckoutsToolDesc = "Lists checkouts (payment links) with their payment status, optionally filtered by reference"
+
+	CheckoutIDField = "checkout_id"
+	CheckoutIDDesc  = "ID of the checkout returned by generate_payment_link_tool (e.g. chk_xxx)"
+
+	CheckoutReferenceField = "reference_id"
+	CheckoutReferenceDesc  = "Your reference of the checkout. Used when checkout_id is not known"
+)
+
+// Pagination fields shared by list tools
+const (
+	LimitField = "limit"
+	LimitDesc  = "Maximum number of results to return (1-100). Defaults to 10"
+
+	StartingAfterField = "starting_after"
+	StartingAfterDesc  = "Cursor for pagination: ID of the last object from the previous page"
+
+	DefaultListLimit = 10
+	MaxListLimit     = 100
+)
+
+// Refund tools
+const (
+	CreateRefundToolName = "tazapay_create_refund_tool"
+	CreateRefundToolDesc = "Creates a full or partial refund for a payin or checkout." +
+		" Omit amount to refund the full amount."
+
+	GetRefundToolName = "tazapay_get_refund_tool"
+	GetRefundToolDesc = "Fetches a refund by its ID and reports its status"
+
+	ListRefundsToolName = "tazapay_list_refunds_tool"
+	ListRefundsToolDesc = "Lists refunds, optionally filtered by payin"
+
+	RefundIDField = "refund_id"
+	RefundIDDesc  = "ID of the refund (e.g. rfd_xxx)"
+
+	RefundPayinField = "payin"
+	RefundPayinDesc  = "ID of the payin to refund. Either payin or checkout is required"
+
+	RefundCheckoutField = "checkout"
+	RefundCheckoutDesc  = "ID of the checkout to refund. Either payin or checkout is required"
+
+	RefundAmountField = "amount"
+	RefundAmountDesc  = "Amount to refund in major units (e.g. 10.50). Omit for a full refund"
+
+	RefundCurrencyField = "currency"
+	RefundCurrencyDesc  = "Currency of the refund amount. Required for partial refunds. Example : USD"
+
+	RefundReasonField = "reason"
+	RefundReasonDesc  = "Reason for the refund, shared with the customer"
+
+	RefundReferenceField = "reference_id"
+	RefundReferenceDesc  = "Your own reference for the refund"
+)
+
+// Beneficiary tools
+const (
+	CreateBeneficiaryToolName = "tazapay_create_beneficiary_tool"
+	CreateBeneficiaryToolDesc = "Creates a payout beneficiary with a bank account, wallet or local payment network" +
+		" (e.g. UPI, PIX, PayNow) as destination"
+
+	GetBeneficiaryToolName = "tazapay_get_beneficiary_tool"
+	GetBeneficiaryToolDesc = "Fetches a beneficiary by its ID"
+
+	ListBeneficiariesToolName = "tazapay_list_beneficiaries_tool"
+	ListBeneficiariesToolDesc = "Lists payout beneficiaries"
+
+	BeneficiaryIDField = "beneficiary_id"
+	BeneficiaryIDDesc  = "ID of the beneficiary (e.g. bnf_xxx)"
+
+	BeneficiaryNameField = "name"
+	BeneficiaryNameDesc  = "Full name of the person or legal name of the business receiving the payout"
+
+	BeneficiaryTypeField = "type"
+	BeneficiaryTypeDesc  = "Beneficiary type: \"individual\" or \"business\""
+
+	BeneficiaryEmailField = "email"
+	BeneficiaryEmailDesc  = "Email address of the beneficiary"
+
+	DestinationTypeField = "destination_type"
+	DestinationTypeDesc  = "Where payouts are sent: \"bank\", \"wallet\" or \"local_payment_network\""
+
+	DestinationCountryField = "country"
+	DestinationCountryDesc  = "2 letter country code of the bank account or local payment network. Example : IN, BR"
+
+	DestinationCurrencyField = "currency"
+	DestinationCurrencyDesc  = "3 letter currency code the beneficiary receives. Example : INR, BRL"
+
+	AccountNumberField = "account_number"
+	AccountNumberDesc  = "Bank account number. Required for bank destinations unless an IBAN is given in bank_codes"
+
+	BankNameField = "bank_name"
+	BankNameDesc  = "Name of the beneficiary's bank"
+
+	BankCodesField = "bank_codes"
+	BankCodesDesc  = "Local bank codes for the country, e.g. {\"swift_code\": \"...\", \"ifsc_code\": \"...\"}." +
+		" Supported keys: swift_code, iban, ifsc_code, aba_code, sort_code, bsb_code, bank_code, branch_code"
+
+	WalletTypeField = "wallet_type"
+	WalletTypeDesc  = "Wallet provider for wallet destinations, e.g. \"paypal\", or the local rail name" +
+		" for local_payment_network destinations, e.g. \"upi\", \"pix\", \"paynow\""
+
+	DepositKeyField = "deposit_key"
+	DepositKeyDesc  = "Wallet ID, email, phone number or VPA the funds are deposited to"
+
+	DepositKeyTypeField = "deposit_key_type"
+	DepositKeyTypeDesc  = "Kind of deposit key for local payment networks, e.g. \"email\", \"phone\", \"vpa\""
+
+	BeneficiaryTypeIndividual = "individual"
+	BeneficiaryTypeBusiness   = "business"
+
+	DestinationBank                = "bank"
+	DestinationWallet              = "wallet"
+	DestinationLocalPaymentNetwork = "local_payment_network"
+)
+
+// Payout tools
+const (
+	QuotePayoutToolName = "tazapay_quote_payout_tool"
+	QuotePayoutToolDesc = "Quotes the FX rate and the holding balance amount needed for a payout." +
+		" Show this to the user before creating a payout in a different currency"
+
+	CreatePayoutToolName = "tazapay_create_payout_tool"
+	CreatePayoutToolDesc = "Creates a payout to a beneficiary, funded from the balance in holding_currency." +
+		" Quote the payout first so the user can confirm the FX rate"
+
+	GetPayoutToolName = "tazapay_get_payout_tool"
+	GetPayoutToolDesc = "Fetches a payout by its ID and reports its status"
+
+	PayoutIDField = "payout_id"
+	PayoutIDDesc  = "ID of the payout (e.g. pot_xxx)"
+
+	PayoutBeneficiaryField = "beneficiary"
+	PayoutBeneficiaryDesc  = "ID of the beneficiary to pay (e.g. bnf_xxx)"
+
+	PayoutAmountField = "amount"
+	PayoutAmountDesc  = "Amount the beneficiary receives, in major units of currency (e.g. 1500.50)"
+
+	PayoutCurrencyField = "currency"
+	PayoutCurrencyDesc  = "3 letter currency code the beneficiary receives. Example : INR"
+
+	HoldingCurrencyField = "holding_currency"
+	HoldingCurrencyDesc  = "3 letter currency code of the balance that funds the payout. Example : USD"
+
+	PayoutPurposeField = "purpose"
+	PayoutPurposeDesc  = "Tazapay purpose code of the payout. Example : PYR001"
+
+	PayoutDescriptionField = "transaction_description"
+	PayoutDescriptionDesc  = "Description of the payout shown to the beneficiary"
+
+	PayoutReferenceField = "reference_id"
+	PayoutReferenceDesc  = "Your own reference for the payout"
+)
+
+// Customer tools
+const (
+	CreateCustomerToolName = "tazapay_create_customer_tool"
+	CreateCustomerToolDesc = "Creates a Tazapay customer to reuse in payment links." +
+		" Returns the existing customer instead if one with the same email already exists"
+
+	UpdateCustomerToolName = "tazapay_update_customer_tool"
+	UpdateCustomerToolDesc = "Updates the name, email, country, phone, reference or metadata of a customer." +
+		" Only the fields passed change"
+
+	GetCustomerToolName = "tazapay_get_customer_tool"
+	GetCustomerToolDesc = "Fetches a customer by ID"
+
+	ListCustomersToolName = "tazapay_list_customers_tool"
+	ListCustomersToolDesc = "Lists customers, optionally filtered by email or reference"
+
+	SearchCustomersToolName = "tazapay_search_customers_tool"
+	SearchCustomersToolDesc = "Finds customers whose name, email or reference contains the query." +
+		" Use it before creating a customer or payment link to reuse an existing customer"
+
+	CustomerIDField = "customer_id"
+	CustomerIDDesc  = "ID of the customer (e.g. cus_xxx)"
+
+	CustomerField = "customer"
+	CustomerDesc  = "ID of an existing customer (e.g. cus_xxx). Replaces customer_name, customer_email and customer_country"
+
+	CustomerPhoneCodeField = "phone_calling_code"
+	CustomerPhoneCodeDesc  = "International calling code of the phone number without +, e.g. 65"
+
+	CustomerPhoneField = "phone_number"
+	CustomerPhoneDesc  = "Phone number without the calling code"
+
+	CustomerReferenceField = "reference_id"
+	CustomerReferenceDesc  = "Your own ID for the customer"
+
+	CustomerEmailFilterDesc = "Only list customers with this email"
+
+	CustomerMetadataDesc = "Key-value pairs of strings stored with the customer"
+
+	CustomerQueryField = "query"
+	CustomerQueryDesc  = "Part of the customer's name, email or reference"
+
+	// MaxCustomerSearchPages bounds how many pages of customers a search reads
+	MaxCustomerSearchPages = 5
+	CustomerSearchPageSize = 100
+)
+
+// Payin inspection tools
+const (
+	GetPayinToolName = "tazapay_get_payin_tool"
+	GetPayinToolDesc = "Fetches a payin by ID: its status, amount, customer and latest payment attempt." +
+		" Use it when a customer says they paid"
+
+	ListPaymentAttemptsToolName = "tazapay_list_payment_attempts_tool"
+	ListPaymentAttemptsToolDesc = "Lists the payment attempts of a payin with their status, payment method," +
+		" decline code and a plain-language explanation of each decline"
+
+	ExplainDeclineToolName = "tazapay_explain_decline_tool"
+	ExplainDeclineToolDesc = "Explains a payment decline code in plain language and says whether the customer" +
+		" can retry or should use another payment method. Does not call the Tazapay API"
+
+	PayinIDField = "payin_id"
+	PayinIDDesc  = "ID of the payin (e.g. pay_xxx)"
+
+	DeclineCodeField = "decline_code"
+	DeclineCodeDesc  = "Decline code of a failed payment attempt, e.g. insufficient_funds"
+)
@@ -0,0 +1,29 @@
+package constants
+
+import "time"
+
+// Transport modes
+const (
+	TransportStdio = "stdio"
+	TransportSSE   = "sse"
+	TransportHTTP  = "http"
+
+	// StdioSessionID is the ID of the only session of the stdio transport
+	StdioSessionID = "stdio"
+)
+
+// HTTP transport defaults and routes
+const (
+	DefaultListenAddr      = ":8080"
+	DefaultShutdownTimeout = 10 * time.Second
+	ReadHeaderTimeout      = 10 * time.Second
+
+	// MaxMCPBodyBytes bounds the size of a request posted to the streamable HTTP endpoint
+	MaxMCPBodyBytes = 4 << 20
+
+	MCPEndpointPath     = "/mcp"
+	SSEEndpointPath     = "/sse"
+	MessageEndpointPath = "/message"
+	HealthzPath         = "/healthz"
+	ReadyzPath          = "/readyz"
+)
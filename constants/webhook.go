@@ -0,0 +1,54 @@
+package constants
+
+import "time"
+
+// Webhook receiver
+const (
+	DefaultWebhookListenAddr = ":8090"
+	DefaultWebhookPath       = "/webhooks/tazapay"
+
+	// The default signing scheme, an HMAC-SHA256 of the timestamp and the raw
+	// body with the webhook secret: hex(HMAC-SHA256(secret, timestamp + "." + body)).
+	// WEBHOOK_SIGNATURE_HEADER, WEBHOOK_TIMESTAMP_HEADER and
+	// WEBHOOK_SIGNATURE_ENCODING adapt it to the scheme of the account.
+	DefaultWebhookSignatureHeader = "X-Tazapay-Signature"
+	DefaultWebhookTimestampHeader = "X-Tazapay-Timestamp"
+
+	WebhookEncodingHex    = "hex"
+	WebhookEncodingBase64 = "base64"
+
+	// DefaultWebhookTolerance is how far a webhook timestamp may be from now, to reject replays
+	DefaultWebhookTolerance = 5 * time.Minute
+
+	// MaxWebhookBodyBytes bounds the size of a webhook request body
+	MaxWebhookBodyBytes = 1 << 20
+
+	// MaxWebhookRefreshes bounds how many events refresh resources at the same
+	// time; further events are coalesced into the next refresh
+	MaxWebhookRefreshes = 4
+)
+
+// Events tool and resource
+const (
+	ListEventsToolName = "tazapay_list_events_tool"
+	ListEventsToolDesc = "Lists Tazapay webhook events received by this server, newest first, e.g. checkout.paid or" +
+		" payout.failed. Events received while the server was down are missing, and with the in-memory store only" +
+		" events received since the server started are available"
+
+	EventTypeField = "type"
+	EventTypeDesc  = "Only list events of this type, e.g. checkout.paid, or of this prefix, e.g. payout."
+
+	EventObjectField = "object_id"
+	EventObjectDesc  = "Only list events about this object, e.g. a checkout or payout ID"
+
+	SinceField = "since"
+	SinceDesc  = "Only list records at or after this RFC 3339 time"
+
+	EventsResourceURI  = "tazapay://events"
+	EventsResourceName = "Recent webhook events"
+	EventsResourceDesc = "The latest Tazapay webhook events received by this server, newest first." +
+		" Sessions that read it are notified when a new event arrives"
+
+	// EventsResourceLimit is how many events the events resource returns
+	EventsResourceLimit = 50
+)
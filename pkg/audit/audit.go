@@ -0,0 +1,448 @@
+// Package audit keeps a tamper-evident record of the tool calls that create
+// or change Tazapay objects. The log is a file of JSON lines, each entry
+// holding the hash of the previous one, so editing, removing or reordering
+// entries breaks the chain and is caught by Verify.
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+)
+
+// Entry is one audited tool call.
+type Entry struct {
+	Seq         int64           `json:"seq"`  // 1 for the first entry of a log
+	Time        time.Time       `json:"time"` // When the entry was appended; never before the previous entry
+	StartedAt   time.Time       `json:"started_at"`
+	Client      Client          `json:"client"`
+	Tool        string          `json:"tool"`
+	Environment string          `json:"environment"`
+	Arguments   json.RawMessage `json:"arguments"` // Redacted, see Redact
+	ObjectIDs   []string        `json:"object_ids,omitempty"`
+	Outcome     string          `json:"outcome"` // As in types.ToolCall
+	ErrorCode   string          `json:"error_code,omitempty"`
+	PrevHash    string          `json:"prev_hash"` // Empty for the first entry of a log
+	Hash        string          `json:"hash"`
+}
+
+// Client identifies who made a call.
+type Client struct {
+	Principal  string `json:"principal,omitempty"`   // Token name or OAuth subject; empty over stdio
+	AuthMethod string `json:"auth_method,omitempty"` // "token" or "oauth"
+	Session    string `json:"session,omitempty"`     // MCP session ID
+	Name       string `json:"name,omitempty"`        // MCP client name, as sent in initialize
+	Version    string `json:"version,omitempty"`
+}
+
+// objectFields are the arguments that name an existing Tazapay object.
+var objectFields = []string{
+	constants.CheckoutIDField, constants.RefundCheckoutField, constants.RefundPayinField, constants.PayinIDField,
+	constants.BeneficiaryIDField, constants.PayoutBeneficiaryField, constants.CustomerIDField, constants.CustomerField,
+	constants.PayoutIDField, constants.RefundIDField,
+}
+
+// ObjectIDs returns the ID of the object a call created, if any, followed by
+// the IDs of the objects its arguments refer to, such as the payin of a refund.
+func ObjectIDs(created string, args map[string]any) []string {
+	var ids []string
+
+	if created != "" {
+		ids = append(ids, created)
+	}
+
+	for _, field := range objectFields {
+		if id, _ := args[field].(string); id != "" && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// hash returns the hash of e chained to prev: the SHA-256 of prev, a newline
+// and the JSON of e with an empty Hash field.
+func hash(prev string, e Entry) (string, error) {
+	e.Hash = ""
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode audit entry: %w", err)
+	}
+
+	sum := sha256.Sum256(append([]byte(prev+"\n"), data...))
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Log appends entries to an audit log file. Several processes may append to
+// the same file: each append locks it and first reads the entries the others
+// wrote since, so they all extend one chain.
+type Log struct {
+	mu        sync.Mutex
+	file      *os.File
+	chain     Summary // The entries read or written so far
+	offset    int64   // Size of the file covered by chain
+	discarded int64   // Bytes of torn entries removed from the file
+	now       func() time.Time
+}
+
+// Open opens the log at path for appending, creating it if needed. An
+// existing log is verified first, so new entries never extend a broken chain,
+// and must start at the first entry. An entry torn by a crash at the end of
+// the log is removed, see Discarded.
+func Open(path string) (*Log, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log %s: %w", path, err)
+	}
+
+	l := &Log{file: file, now: time.Now}
+
+	if err := l.locked(l.catchUp); err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("audit log %s: %w", path, err)
+	}
+
+	return l, nil
+}
+
+// Append completes e with its sequence number, time and hashes and writes it
+// to the log, returning the entry as written. Entries are stamped when they
+// are appended rather than when their call started, so that times follow
+// sequence numbers and a time range of the log is a contiguous part of the
+// chain.
+func (l *Log) Append(e Entry) (Entry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	err := l.locked(func() error {
+		if err := l.catchUp(); err != nil {
+			return err
+		}
+
+		e.Seq = l.chain.LastSeq + 1
+		// A clock set back, or another process's clock, must not reorder the log
+		if e.Time = l.now().UTC(); e.Time.Before(l.chain.LastTime) {
+			e.Time = l.chain.LastTime
+		}
+
+		e.StartedAt = e.StartedAt.UTC()
+		e.PrevHash = l.chain.Head
+
+		if err := fit(&e); err != nil {
+			return err
+		}
+
+		var err error
+		if e.Hash, err = hash(e.PrevHash, e); err != nil {
+			return err
+		}
+
+		line, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("failed to encode audit entry: %w", err)
+		}
+
+		n, err := l.file.Write(append(line, '\n'))
+		if err == nil {
+			// An entry the caller was told about must survive a crash
+			err = l.file.Sync()
+		}
+
+		if err != nil {
+			// Leave no part of an entry the caller is told failed
+			_ = l.file.Truncate(l.offset)
+			return fmt.Errorf("failed to write audit entry: %w", err)
+		}
+
+		l.chain.Entries++
+		l.chain.LastSeq, l.chain.Head, l.chain.LastTime = e.Seq, e.Hash, e.Time
+		l.offset += int64(n)
+
+		return nil
+	})
+	if err != nil {
+		return Entry{}, err
+	}
+
+	return e, nil
+}
+
+// truncatedArguments replaces the arguments of an entry too large to be read back.
+type truncatedArguments struct {
+	Truncated bool   `json:"truncated"`
+	Bytes     int    `json:"bytes"`  // Size of the redacted arguments
+	SHA256    string `json:"sha256"` // Digest of the redacted arguments
+}
+
+// fit replaces the arguments of e with their size and digest when the entry
+// would be longer than a line the log can be read back with, so that one
+// large call cannot make the log unreadable. It fails when the entry is still
+// too long without its arguments.
+func fit(e *Entry) error {
+	size := func() (int, error) {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return 0, fmt.Errorf("failed to encode audit entry: %w", err)
+		}
+
+		// The hash, not yet known, is as long as any other
+		return len(data) + hex.EncodedLen(sha256.Size) - len(e.Hash), nil
+	}
+
+	n, err := size()
+	if err != nil || n < constants.MaxAuditLineBytes {
+		return err
+	}
+
+	sum := sha256.Sum256(e.Arguments)
+
+	if e.Arguments, err = json.Marshal(truncatedArguments{
+		Truncated: true, Bytes: len(e.Arguments), SHA256: hex.EncodeToString(sum[:]),
+	}); err != nil {
+		return fmt.Errorf("failed to encode audit entry: %w", err)
+	}
+
+	if n, err = size(); err != nil || n < constants.MaxAuditLineBytes {
+		return err
+	}
+
+	return fmt.Errorf("%w: %d bytes without its arguments", constants.ErrAuditEntryTooLarge, n)
+}
+
+// locked runs fn while holding the file lock.
+func (l *Log) locked(fn func() error) error {
+	if err := lock(l.file); err != nil {
+		return fmt.Errorf("failed to lock audit log: %w", err)
+	}
+
+	err := fn()
+
+	if uerr := unlock(l.file); uerr != nil && err == nil {
+		err = fmt.Errorf("failed to unlock audit log: %w", uerr)
+	}
+
+	return err
+}
+
+// catchUp verifies the entries appended to the file since it was last read
+// and extends the chain with them. The file must be locked.
+func (l *Log) catchUp() error {
+	size, err := l.file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return fmt.Errorf("failed to read audit log: %w", err)
+	}
+
+	if size == l.offset {
+		return nil
+	}
+
+	if size, err = l.repair(size); err != nil {
+		return err
+	}
+
+	r := io.NewSectionReader(l.file, l.offset, size-l.offset)
+	line := l.chain.Entries + 1
+
+	if err := scan(r, line, func(n int, _ []byte, e Entry) error { return l.chain.extend(n, e, false) }); err != nil {
+		return err
+	}
+
+	l.offset = size
+
+	return nil
+}
+
+// repair handles the bytes after the last newline of a file of the given
+// size and returns its new size. Appends write whole lines under the lock, so
+// such bytes were left by a crash during a write: a complete entry only lost
+// its newline and is kept, anything else is part of an entry its caller was
+// never told about and is removed. The file must be locked.
+func (l *Log) repair(size int64) (int64, error) {
+	end, err := l.lastLineEnd(size)
+	if err != nil || end == size {
+		return size, err
+	}
+
+	if size-end < constants.MaxAuditLineBytes {
+		tail := make([]byte, size-end)
+		if _, err := l.file.ReadAt(tail, end); err != nil {
+			return 0, fmt.Errorf("failed to read audit log: %w", err)
+		}
+
+		var e Entry
+		if json.Unmarshal(tail, &e) == nil {
+			if _, err := l.file.Write([]byte{'\n'}); err != nil {
+				return 0, fmt.Errorf("failed to repair audit log: %w", err)
+			}
+
+			return size + 1, nil
+		}
+	}
+
+	if err := l.file.Truncate(end); err != nil {
+		return 0, fmt.Errorf("failed to repair audit log: %w", err)
+	}
+
+	l.discarded += size - end
+
+	return end, nil
+}
+
+// lastLineEnd returns the offset after the last newline of a file of the
+// given size, or l.offset when there is none after it.
+func (l *Log) lastLineEnd(size int64) (int64, error) {
+	buf := make([]byte, 64*1024)
+
+	for end := size; end > l.offset; {
+		start := max(end-int64(len(buf)), l.offset)
+
+		if _, err := l.file.ReadAt(buf[:end-start], start); err != nil {
+			return 0, fmt.Errorf("failed to read audit log: %w", err)
+		}
+
+		if i := bytes.LastIndexByte(buf[:end-start], '\n'); i >= 0 {
+			return start + int64(i) + 1, nil
+		}
+
+		end = start
+	}
+
+	return l.offset, nil
+}
+
+// Discarded returns how many bytes of torn entries were removed from the end
+// of the log since it was opened.
+func (l *Log) Discarded() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.discarded
+}
+
+// Close closes the log file.
+func (l *Log) Close() error {
+	return l.file.Close()
+}
+
+// Summary describes a verified log.
+type Summary struct {
+	Entries  int
+	FirstSeq int64
+	LastSeq  int64
+	Head     string    // Hash of the last entry
+	LastTime time.Time // Time of the last entry
+	Partial  bool      // The log starts after the first entry, as an export may
+}
+
+// Verify reads a log and checks that every entry's hash is correct and
+// chained to the previous entry. A log that does not start at the first
+// entry has had entries removed, unless allowPartial is set for an export:
+// it is then accepted as Partial, with its first link taken on trust.
+func Verify(r io.Reader, allowPartial bool) (Summary, error) {
+	var s Summary
+
+	err := scan(r, 1, func(n int, _ []byte, e Entry) error { return s.extend(n, e, allowPartial) })
+
+	return s, err
+}
+
+// extend checks that e, read from line n, follows the entries in s and adds it.
+func (s *Summary) extend(n int, e Entry, allowPartial bool) error {
+	switch {
+	case s.Entries == 0 && e.Seq == 1 && e.PrevHash != "":
+		return fmt.Errorf("%w: line %d: the first entry has a previous hash", constants.ErrAuditChainBroken, n)
+
+	case s.Entries == 0 && e.Seq != 1 && !allowPartial:
+		return fmt.Errorf("%w: line %d: the log starts at entry %d, earlier entries are missing",
+			constants.ErrAuditChainBroken, n, e.Seq)
+
+	case s.Entries == 0:
+		s.FirstSeq, s.Partial = e.Seq, e.Seq != 1
+
+	case e.Seq != s.LastSeq+1:
+		return fmt.Errorf("%w: line %d: entry %d follows entry %d", constants.ErrAuditChainBroken, n, e.Seq, s.LastSeq)
+
+	case e.PrevHash != s.Head:
+		return fmt.Errorf("%w: line %d: entry %d is not chained to entry %d", constants.ErrAuditChainBroken, n,
+			e.Seq, s.LastSeq)
+	}
+
+	want, err := hash(e.PrevHash, e)
+	if err != nil {
+		return err
+	}
+
+	if e.Hash != want {
+		return fmt.Errorf("%w: line %d: entry %d was modified", constants.ErrAuditChainBroken, n, e.Seq)
+	}
+
+	s.Entries++
+	s.LastSeq, s.Head, s.LastTime = e.Seq, e.Hash, e.Time
+
+	return nil
+}
+
+// Export copies the entries with a time in [from, to) from r to w as they
+// are, so the copy can still be verified, and returns how many it copied.
+// Zero bounds are open.
+func Export(r io.Reader, w io.Writer, from, to time.Time) (int, error) {
+	n := 0
+
+	err := scan(r, 1, func(_ int, line []byte, e Entry) error {
+		if (!from.IsZero() && e.Time.Before(from)) || (!to.IsZero() && !e.Time.Before(to)) {
+			return nil
+		}
+
+		// Clipped, so the newline is not written into the scanner's buffer
+		if _, err := w.Write(append(slices.Clip(line), '\n')); err != nil {
+			return fmt.Errorf("failed to write audit entry: %w", err)
+		}
+
+		n++
+
+		return nil
+	})
+
+	return n, err
+}
+
+// scan decodes each line of r and passes it to fn with its line number,
+// counting from first. The line is only valid until fn returns.
+func scan(r io.Reader, first int, fn func(n int, line []byte, e Entry) error) error {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), constants.MaxAuditLineBytes)
+
+	for n := first; sc.Scan(); n++ {
+		var e Entry
+		if err := json.Unmarshal(sc.Bytes(), &e); err != nil {
+			return fmt.Errorf("%w: line %d: %w", constants.ErrInvalidAuditEntry, n, err)
+		}
+
+		if err := fn(n, sc.Bytes(), e); err != nil {
+			return err
+		}
+	}
+
+	if err := sc.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			return fmt.Errorf("%w: %w", constants.ErrInvalidAuditEntry, err)
+		}
+
+		return fmt.Errorf("failed to read audit log: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,340 @@
+package audit_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/audit"
+	"github.com/tazapay/tazapay-mcp-server/pkg/redact"
+)
+
+var base = time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC)
+
+// writeLog appends n entries a day apart to a new log and returns its path.
+func writeLog(t *testing.T, n int) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	// Reopening between entries must continue the chain
+	for i := range n {
+		log, err := audit.Open(path)
+		if err != nil {
+			t.Fatalf("failed to open audit log: %v", err)
+		}
+
+		audit.SetClock(log, func() time.Time { return base.Add(time.Duration(i) * 24 * time.Hour) })
+
+		_, err = log.Append(audit.Entry{
+			StartedAt: base.Add(time.Duration(i) * 24 * time.Hour),
+			Client:    audit.Client{Principal: "ops"},
+			Tool:      constants.CreateRefundToolName,
+			Arguments: json.RawMessage(`{"payin":"pay_1","reason":"duplicate"}`),
+			Outcome:   constants.OutcomeOK,
+		})
+		if err != nil {
+			t.Fatalf("failed to append audit entry: %v", err)
+		}
+
+		_ = log.Close()
+	}
+
+	return path
+}
+
+func TestVerifyDetectsTampering(t *testing.T) {
+	path := writeLog(t, 3)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := audit.Verify(bytes.NewReader(data), false)
+	if err != nil || s.Entries != 3 || s.LastSeq != 3 || s.Partial {
+		t.Fatalf("expected a valid chain of 3 entries, got: %+v, %v", s, err)
+	}
+
+	lines := strings.SplitAfter(string(data), "\n")
+
+	tampered := strings.Replace(string(data), `"reason":"duplicate"`, `"reason":"fraud"`, 1)
+	if _, err := audit.Verify(strings.NewReader(tampered), false); !errors.Is(err, constants.ErrAuditChainBroken) {
+		t.Errorf("expected a modified entry to break the chain, got: %v", err)
+	}
+
+	removed := lines[0] + lines[2]
+	if _, err := audit.Verify(strings.NewReader(removed), false); !errors.Is(err, constants.ErrAuditChainBroken) {
+		t.Errorf("expected a removed entry to break the chain, got: %v", err)
+	}
+
+	if _, err := audit.Open(writeFile(t, tampered)); !errors.Is(err, constants.ErrAuditChainBroken) {
+		t.Errorf("expected a broken log not to be extended, got: %v", err)
+	}
+
+	headless := lines[1] + lines[2]
+	if _, err := audit.Verify(strings.NewReader(headless), false); !errors.Is(err, constants.ErrAuditChainBroken) {
+		t.Errorf("expected a log without its first entry to break the chain, got: %v", err)
+	}
+
+	if _, err := audit.Open(writeFile(t, headless)); !errors.Is(err, constants.ErrAuditChainBroken) {
+		t.Errorf("expected a log without its first entry not to be extended, got: %v", err)
+	}
+}
+
+func TestConcurrentLogsShareChain(t *testing.T) {
+	path := writeLog(t, 1)
+
+	// Two processes appending to one file each hold their own Log
+	first, err := audit.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer first.Close()
+
+	second, err := audit.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer second.Close()
+
+	for i, log := range []*audit.Log{first, second, first} {
+		e, err := log.Append(audit.Entry{StartedAt: base, Tool: constants.CreatePayoutToolName, Outcome: constants.OutcomeOK})
+		if err != nil {
+			t.Fatalf("failed to append audit entry: %v", err)
+		}
+
+		if e.Seq != int64(i+2) {
+			t.Errorf("expected entry %d, got: %d", i+2, e.Seq)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if s, err := audit.Verify(f, false); err != nil || s.LastSeq != 4 {
+		t.Errorf("expected a valid chain of 4 entries, got: %+v, %v", s, err)
+	}
+}
+
+func TestExportRange(t *testing.T) {
+	path := writeLog(t, 4)
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var out bytes.Buffer
+
+	n, err := audit.Export(f, &out, base.Add(24*time.Hour), base.Add(3*24*time.Hour))
+	if err != nil || n != 2 {
+		t.Fatalf("expected 2 exported entries, got: %d, %v", n, err)
+	}
+
+	exported := out.String()
+
+	s, err := audit.Verify(strings.NewReader(exported), true)
+	if err != nil || s.FirstSeq != 2 || s.LastSeq != 3 || !s.Partial {
+		t.Errorf("expected the export to verify as entries 2 to 3, got: %+v, %v", s, err)
+	}
+
+	if _, err := audit.Verify(strings.NewReader(exported), false); !errors.Is(err, constants.ErrAuditChainBroken) {
+		t.Errorf("expected a partial log to be rejected unless allowed, got: %v", err)
+	}
+}
+
+func TestEntriesAreStampedWhenAppended(t *testing.T) {
+	log, err := audit.Open(filepath.Join(t.TempDir(), "audit.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer log.Close()
+
+	// A slow call appended after a faster one that started later, then a
+	// clock set back
+	clock := []time.Time{base.Add(time.Hour), base.Add(2 * time.Hour), base}
+	started := []time.Time{base, base.Add(90 * time.Minute), base}
+	times := make([]time.Time, 0, len(clock))
+
+	for i := range clock {
+		audit.SetClock(log, func() time.Time { return clock[i] })
+
+		e, err := log.Append(audit.Entry{StartedAt: started[i], Tool: constants.CreateRefundToolName})
+		if err != nil {
+			t.Fatalf("failed to append audit entry: %v", err)
+		}
+
+		if !e.StartedAt.Equal(started[i]) {
+			t.Errorf("expected entry %d to keep its start time, got: %v", e.Seq, e.StartedAt)
+		}
+
+		times = append(times, e.Time)
+	}
+
+	want := []time.Time{base.Add(time.Hour), base.Add(2 * time.Hour), base.Add(2 * time.Hour)}
+	if !slices.EqualFunc(times, want, time.Time.Equal) {
+		t.Errorf("expected entry times to follow the chain, got: %v", times)
+	}
+}
+
+func TestOpenRepairsTornEntry(t *testing.T) {
+	path := writeLog(t, 2)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		data      string
+		discarded int64
+	}{
+		{"partial entry", string(data) + `{"seq":3,"time":"2025-`, int64(len(`{"seq":3,"time":"2025-`))},
+		{"missing newline", strings.TrimSuffix(string(data), "\n"), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeFile(t, tt.data)
+
+			log, err := audit.Open(path)
+			if err != nil {
+				t.Fatalf("failed to open audit log: %v", err)
+			}
+			defer log.Close()
+
+			if n := log.Discarded(); n != tt.discarded {
+				t.Errorf("expected %d discarded bytes, got: %d", tt.discarded, n)
+			}
+
+			if e, err := log.Append(audit.Entry{Tool: constants.CreateRefundToolName}); err != nil || e.Seq != 3 {
+				t.Fatalf("expected to append entry 3, got: %+v, %v", e, err)
+			}
+
+			f, err := os.Open(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+
+			if s, err := audit.Verify(f, false); err != nil || s.Entries != 3 {
+				t.Errorf("expected 3 verified entries, got: %+v, %v", s, err)
+			}
+		})
+	}
+}
+
+func TestLargeArgumentsAreSummarised(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	log, err := audit.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	args, err := json.Marshal(map[string]string{"description": strings.Repeat("x", constants.MaxAuditLineBytes)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e, err := log.Append(audit.Entry{Tool: constants.CreateRefundToolName, Arguments: args})
+	if err != nil {
+		t.Fatalf("failed to append audit entry: %v", err)
+	}
+
+	_ = log.Close()
+
+	var summary struct {
+		Truncated bool `json:"truncated"`
+		Bytes     int  `json:"bytes"`
+	}
+	if err := json.Unmarshal(e.Arguments, &summary); err != nil || !summary.Truncated || summary.Bytes != len(args) {
+		t.Errorf("expected the arguments to be summarised, got: %.100s", e.Arguments)
+	}
+
+	// The log must still open and verify
+	if log, err = audit.Open(path); err != nil {
+		t.Fatalf("failed to reopen audit log: %v", err)
+	}
+	defer log.Close()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s, err := audit.Verify(bytes.NewReader(data), false); err != nil || s.Entries != 1 {
+		t.Errorf("expected one verified entry, got: %+v, %v", s, err)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	got := audit.Redact(constants.PaymentLinkToolName, map[string]any{
+		constants.CustomerNameField:      "Ada Lovelace",
+		constants.CustomerEmailField:     "ada@example.com",
+		constants.AccountNumberField:     "12345678",
+		constants.BillingAddressField:    map[string]any{"line1": "1 Main St"},
+		constants.ConfirmationTokenField: "tok",
+		constants.PaymentAmountField:     10.5,
+		constants.ItemsField: []any{map[string]any{
+			"name": "Analytical Engine", constants.BeneficiaryEmailField: "bob@example.com",
+		}},
+		"customer_details":             map[string]any{"name": "Ada Lovelace"},
+		constants.TransactionDescField: "Invoice for Ada Lovelace",
+		constants.CustomerQueryField:   "ada@example.com",
+		constants.MetadataField:        map[string]any{"contact": "ada@example.com"},
+	})
+
+	want := map[string]any{
+		constants.CustomerNameField:   redact.Hash("Ada Lovelace"),
+		constants.CustomerEmailField:  "a***@example.com",
+		constants.AccountNumberField:  "****5678",
+		constants.BillingAddressField: constants.Redacted,
+		constants.PaymentAmountField:  10.5,
+		constants.ItemsField: []any{map[string]any{
+			"name": "Analytical Engine", constants.BeneficiaryEmailField: "b***@example.com",
+		}},
+		"customer_details":             map[string]any{"name": redact.Hash("Ada Lovelace")},
+		constants.TransactionDescField: redact.Hash("Invoice for Ada Lovelace"),
+		constants.CustomerQueryField:   redact.Hash("ada@example.com"),
+		constants.MetadataField:        constants.Redacted,
+	}
+
+	gotJSON, _ := json.Marshal(got)
+	wantJSON, _ := json.Marshal(want)
+
+	if string(gotJSON) != string(wantJSON) {
+		t.Errorf("expected %s, got: %s", wantJSON, gotJSON)
+	}
+
+	// The name argument of the beneficiary tool is the beneficiary's
+	got = audit.Redact(constants.CreateBeneficiaryToolName, map[string]any{constants.BeneficiaryNameField: "Bob"})
+	if got[constants.BeneficiaryNameField] != redact.Hash("Bob") {
+		t.Errorf("expected the beneficiary name to be hashed, got: %v", got)
+	}
+}
+
+// writeFile writes data to a new file and returns its path.
+func writeFile(t *testing.T, data string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
@@ -0,0 +1,8 @@
+package audit
+
+import "time"
+
+// SetClock replaces the clock that stamps the entries appended to l.
+func SetClock(l *Log, now func() time.Time) {
+	l.now = now
+}
@@ -0,0 +1,65 @@
+package audit
+
+import (
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/redact"
+)
+
+// Redact returns a copy of the arguments of a tool with personal data
+// redacted by the rules in redact.Fields, at any depth, and by those in
+// redact.Scoped for the objects they apply to, including the arguments of
+// the tools in redact.ToolScopes. The confirmation token is left out. Enough
+// is kept to recognize a value, e.g. the domain of an email address or the
+// last digits of an account number.
+func Redact(tool string, args map[string]any) map[string]any {
+	return redactObject(args, redact.Scoped[redact.ToolScopes[tool]])
+}
+
+// redactObject redacts the fields of an object, applying the scoped rules
+// before the general ones.
+func redactObject(obj map[string]any, scoped map[string]string) map[string]any {
+	out := make(map[string]any, len(obj))
+
+	for field, value := range obj {
+		action, ok := scoped[field]
+		if !ok {
+			action, ok = redact.Fields[field]
+		}
+
+		switch s, isString := value.(string); {
+		case field == constants.ConfirmationTokenField:
+			continue
+
+		case !ok:
+			out[field] = redactValue(value, redact.Scoped[field])
+
+		case isString:
+			out[field] = redact.Apply(action, s)
+
+		default:
+			out[field] = constants.Redacted
+		}
+	}
+
+	return out
+}
+
+// redactValue redacts the objects nested in a value, with the scoped rules
+// of the field holding it.
+func redactValue(value any, scoped map[string]string) any {
+	switch v := value.(type) {
+	case map[string]any:
+		return redactObject(v, scoped)
+
+	case []any:
+		out := make([]any, len(v))
+		for i := range v {
+			out[i] = redactValue(v[i], scoped)
+		}
+
+		return out
+
+	default:
+		return value
+	}
+}
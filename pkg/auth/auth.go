@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+)
+
+// Principal identifies the authenticated caller of an MCP session.
+type Principal struct {
+	Subject string   // Token name or JWT "sub" claim
+	Method  string   // "token" or "oauth"
+	Scopes  []string // Granted scopes; empty for static tokens, which are unrestricted
+}
+
+// HasScope reports whether the principal was granted the given scope.
+// Static tokens carry no scopes and are treated as holding every scope.
+func (p *Principal) HasScope(scope string) bool {
+	if p.Method == MethodToken {
+		return true
+	}
+
+	return slices.Contains(p.Scopes, scope)
+}
+
+// Authentication methods
+const (
+	MethodToken = "token"
+	MethodOAuth = "oauth"
+)
+
+// Authenticator validates a bearer token and returns the caller it belongs to.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (*Principal, error)
+}
+
+// Chain tries each authenticator in order and returns the first successful result.
+type Chain []Authenticator
+
+// Authenticate implements Authenticator.
+func (c Chain) Authenticate(ctx context.Context, token string) (*Principal, error) {
+	errs := make([]error, 0, len(c))
+
+	for _, a := range c {
+		p, err := a.Authenticate(ctx, token)
+		if err == nil {
+			return p, nil
+		}
+
+		errs = append(errs, err)
+	}
+
+	if len(errs) == 0 {
+		return nil, constants.ErrInvalidToken
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+type principalKey struct{}
+
+// WithPrincipal stores the authenticated principal in the context.
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the authenticated principal, if any.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok && p != nil
+}
@@ -0,0 +1,298 @@
+package auth_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/auth"
+)
+
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func writeJWKS(t *testing.T, keys ...map[string]string) string {
+	t.Helper()
+
+	data, err := json.Marshal(map[string]any{"keys": keys})
+	if err != nil {
+		t.Fatalf("failed to marshal JWKS: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("failed to write JWKS: %v", err)
+	}
+
+	return path
+}
+
+func signingInput(t *testing.T, alg, kid string, claims map[string]any) string {
+	t.Helper()
+
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"}) //nolint: errcheck // static input
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatalf("failed to marshal claims: %v", err)
+	}
+
+	return b64(header) + "." + b64(payload)
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]any) string {
+	t.Helper()
+
+	input := signingInput(t, "RS256", kid, claims)
+	digest := sha256.Sum256([]byte(input))
+
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	return input + "." + b64(sig)
+}
+
+func newRSAValidator(t *testing.T) (*rsa.PrivateKey, *auth.JWTValidator) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate RSA key: %v", err)
+	}
+
+	path := writeJWKS(t, map[string]string{
+		"kty": "RSA", "kid": "rsa-1", "use": "sig",
+		"n": b64(key.N.Bytes()), "e": b64(big.NewInt(int64(key.E)).Bytes()),
+	})
+
+	v, err := auth.NewJWTValidator(auth.OAuthConfig{
+		JWKSFile: path,
+		Issuer:   "https://auth.example.com",
+		Audience: "https://mcp.example.com/mcp",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	return key, v
+}
+
+func validClaims() map[string]any {
+	return map[string]any{
+		"sub":   "agent-1",
+		"iss":   "https://auth.example.com",
+		"aud":   []string{"https://mcp.example.com/mcp"},
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"scope": "payments:read payments:write",
+	}
+}
+
+func TestStaticTokens(t *testing.T) {
+	a, err := auth.NewStaticTokens([]string{"support:s3cret", "anonymous-token"})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	p, err := a.Authenticate(t.Context(), "s3cret")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if p.Subject != "support" || !p.HasScope("anything") {
+		t.Errorf("unexpected principal: %+v", p)
+	}
+
+	p, err = a.Authenticate(t.Context(), "anonymous-token")
+	if err != nil || p.Subject != "token-2" {
+		t.Errorf("expected token-2, got: %+v, %v", p, err)
+	}
+
+	if _, err := a.Authenticate(t.Context(), "wrong"); !errors.Is(err, constants.ErrInvalidToken) {
+		t.Errorf("expected ErrInvalidToken, got: %v", err)
+	}
+
+	for _, entry := range []string{":s3cret", "support:"} {
+		if _, err := auth.NewStaticTokens([]string{entry}); !errors.Is(err, constants.ErrInvalidStaticToken) {
+			t.Errorf("expected ErrInvalidStaticToken for %q, got: %v", entry, err)
+		}
+	}
+}
+
+func TestJWTValidatorRS256(t *testing.T) {
+	key, v := newRSAValidator(t)
+
+	p, err := v.Authenticate(t.Context(), signRS256(t, key, "rsa-1", validClaims()))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if p.Subject != "agent-1" || !p.HasScope("payments:write") || p.HasScope("admin") {
+		t.Errorf("unexpected principal: %+v", p)
+	}
+}
+
+func TestJWTValidatorRejectsBadClaims(t *testing.T) {
+	key, v := newRSAValidator(t)
+
+	tests := []struct {
+		name   string
+		mutate func(map[string]any)
+		want   error
+	}{
+		{"expired", func(c map[string]any) { c["exp"] = time.Now().Add(-time.Hour).Unix() }, constants.ErrTokenExpired},
+		{"wrong issuer", func(c map[string]any) { c["iss"] = "https://evil.example.com" }, constants.ErrInvalidIssuer},
+		{"wrong audience", func(c map[string]any) { c["aud"] = "https://other.example.com" }, constants.ErrInvalidAudience},
+		{"foreign audiences", func(c map[string]any) {
+			c["aud"] = []string{"https://api.example.com", "https://mcp.example.com"}
+		}, constants.ErrInvalidAudience},
+		{"missing audience", func(c map[string]any) { delete(c, "aud") }, constants.ErrInvalidAudience},
+		{"missing issuer", func(c map[string]any) { delete(c, "iss") }, constants.ErrInvalidIssuer},
+		{"missing exp", func(c map[string]any) { delete(c, "exp") }, constants.ErrInvalidToken},
+		{"missing subject", func(c map[string]any) { delete(c, "sub") }, constants.ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := validClaims()
+			tt.mutate(claims)
+
+			if _, err := v.Authenticate(t.Context(), signRS256(t, key, "rsa-1", claims)); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got: %v", tt.want, err)
+			}
+		})
+	}
+
+	// Tampered payload must fail signature verification.
+	token := signRS256(t, key, "rsa-1", validClaims())
+	parts := strings.Split(token, ".")
+	claims := validClaims()
+	claims["sub"] = "someone-else"
+	forged := signingInput(t, "RS256", "rsa-1", claims)
+
+	if _, err := v.Authenticate(t.Context(), forged+"."+parts[2]); !errors.Is(err, constants.ErrInvalidToken) {
+		t.Errorf("expected ErrInvalidToken for forged token, got: %v", err)
+	}
+
+	if _, err := v.Authenticate(t.Context(), signRS256(t, key, "unknown", validClaims())); !errors.Is(err, constants.ErrUnknownSigningKey) {
+		t.Errorf("expected ErrUnknownSigningKey, got: %v", err)
+	}
+}
+
+func TestJWTValidatorRequiresIssuerAndAudience(t *testing.T) {
+	path := writeJWKS(t, map[string]string{"kty": "OKP", "kid": "ed-1", "crv": "Ed25519", "x": b64(make([]byte, 32))})
+
+	tests := []struct {
+		name string
+		cfg  auth.OAuthConfig
+		want error
+	}{
+		{"no audience", auth.OAuthConfig{JWKSFile: path, Issuer: "https://auth.example.com"}, constants.ErrMissingAuthAudience},
+		{"no issuer", auth.OAuthConfig{JWKSFile: path, Audience: "https://mcp.example.com/mcp"}, constants.ErrMissingAuthIssuer},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := auth.NewJWTValidator(tt.cfg); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got: %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestJWTValidatorES256(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate EC key: %v", err)
+	}
+
+	path := writeJWKS(t, map[string]string{
+		"kty": "EC", "kid": "ec-1", "crv": "P-256",
+		"x": b64(key.X.FillBytes(make([]byte, 32))), "y": b64(key.Y.FillBytes(make([]byte, 32))),
+	})
+
+	v, err := auth.NewJWTValidator(auth.OAuthConfig{
+		JWKSFile: path,
+		Issuer:   "https://auth.example.com",
+		Audience: "https://mcp.example.com/mcp",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	input := signingInput(t, "ES256", "ec-1", validClaims())
+	digest := sha256.Sum256([]byte(input))
+
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	sig := append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+
+	if _, err := v.Authenticate(t.Context(), input+"."+b64(sig)); err != nil {
+		t.Errorf("expected no error, got: %v", err)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	a, err := auth.NewStaticTokens([]string{"team:good-token"})
+	if err != nil {
+		t.Fatalf("failed to build tokens: %v", err)
+	}
+
+	var seen *auth.Principal
+
+	next := http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		seen, _ = auth.PrincipalFromContext(r.Context())
+	})
+	h := auth.Middleware(next, a, "https://mcp.example.com"+auth.MetadataPath, logger)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/mcp", http.NoBody))
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without token, got: %d", rec.Code)
+	}
+
+	if !strings.Contains(rec.Header().Get("WWW-Authenticate"), "resource_metadata=") {
+		t.Errorf("expected resource_metadata in challenge, got: %s", rec.Header().Get("WWW-Authenticate"))
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/mcp", http.NoBody)
+	req.Header.Set("Authorization", "Bearer bad-token")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized || seen != nil {
+		t.Fatalf("expected 401 for bad token, got: %d", rec.Code)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/mcp", http.NoBody)
+	req.Header.Set("Authorization", "Bearer good-token")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK || seen == nil || seen.Subject != "team" {
+		t.Errorf("expected authenticated request, got: %d, principal %+v", rec.Code, seen)
+	}
+}
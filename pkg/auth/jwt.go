@@ -0,0 +1,391 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"math/big"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+)
+
+// OAuthConfig configures OAuth 2.1 resource-server token validation.
+type OAuthConfig struct {
+	JWKSFile string        // Path to a JSON Web Key Set used to verify access tokens
+	Issuer   string        // Expected "iss" claim; required
+	Audience string        // Expected "aud" claim, the URL of this resource server; required
+	Leeway   time.Duration // Allowed clock skew for "exp" and "nbf"
+}
+
+// JWTValidator validates signed JWT access tokens against a local JWKS.
+type JWTValidator struct {
+	cfg  OAuthConfig
+	keys map[string]crypto.PublicKey
+	now  func() time.Time
+}
+
+// NewJWTValidator loads the JWKS file and returns a validator. The issuer and
+// audience are required: without them any token signed by the identity
+// provider would be accepted, including tokens issued for other resources.
+func NewJWTValidator(cfg OAuthConfig) (*JWTValidator, error) {
+	if cfg.Audience == "" {
+		return nil, constants.ErrMissingAuthAudience
+	}
+
+	if cfg.Issuer == "" {
+		return nil, constants.ErrMissingAuthIssuer
+	}
+
+	data, err := os.ReadFile(cfg.JWKSFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS file: %w", err)
+	}
+
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JWTValidator{cfg: cfg, keys: keys, now: time.Now}, nil
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	Typ string `json:"typ"`
+}
+
+type jwtClaims struct {
+	Subject   string          `json:"sub"`
+	Issuer    string          `json:"iss"`
+	Audience  json.RawMessage `json:"aud"`
+	ExpiresAt *int64          `json:"exp"`
+	NotBefore *int64          `json:"nbf"`
+	Scope     string          `json:"scope"`
+	Scp       []string        `json:"scp"`
+	ClientID  string          `json:"client_id"`
+}
+
+// Authenticate implements Authenticator.
+func (v *JWTValidator) Authenticate(_ context.Context, token string) (*Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != constants.Num3 {
+		return nil, constants.ErrInvalidToken
+	}
+
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, err
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed signature", constants.ErrInvalidToken)
+	}
+
+	key, err := v.lookupKey(header.Kid)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifySignature(header.Alg, key, parts[0]+"."+parts[1], sig); err != nil {
+		return nil, err
+	}
+
+	var claims jwtClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, err
+	}
+
+	if err := v.validateClaims(&claims); err != nil {
+		return nil, err
+	}
+
+	subject := claims.Subject
+	if subject == "" {
+		subject = claims.ClientID
+	}
+
+	// Records are scoped to the caller, so every caller needs an identity
+	if subject == "" {
+		return nil, fmt.Errorf("%w: missing sub and client_id", constants.ErrInvalidToken)
+	}
+
+	scopes := claims.Scp
+	if claims.Scope != "" {
+		scopes = strings.Fields(claims.Scope)
+	}
+
+	return &Principal{Subject: subject, Method: MethodOAuth, Scopes: scopes}, nil
+}
+
+// lookupKey returns the key for kid, or the only key when the token carries no kid.
+func (v *JWTValidator) lookupKey(kid string) (crypto.PublicKey, error) {
+	if key, ok := v.keys[kid]; ok {
+		return key, nil
+	}
+
+	if kid == "" && len(v.keys) == 1 {
+		for _, key := range v.keys {
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %q", constants.ErrUnknownSigningKey, kid)
+}
+
+// validateClaims checks expiry, not-before, issuer and audience.
+func (v *JWTValidator) validateClaims(c *jwtClaims) error {
+	now := v.now()
+
+	if c.ExpiresAt == nil {
+		return fmt.Errorf("%w: missing exp", constants.ErrInvalidToken)
+	}
+
+	if now.After(time.Unix(*c.ExpiresAt, 0).Add(v.cfg.Leeway)) {
+		return constants.ErrTokenExpired
+	}
+
+	if c.NotBefore != nil && now.Add(v.cfg.Leeway).Before(time.Unix(*c.NotBefore, 0)) {
+		return constants.ErrTokenNotYetValid
+	}
+
+	if c.Issuer != v.cfg.Issuer {
+		return constants.ErrInvalidIssuer
+	}
+
+	if !slices.Contains(audiences(c.Audience), v.cfg.Audience) {
+		return constants.ErrInvalidAudience
+	}
+
+	return nil
+}
+
+// audiences accepts both the string and the array form of the "aud" claim.
+func audiences(raw json.RawMessage) []string {
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		return []string{single}
+	}
+
+	var many []string
+	if err := json.Unmarshal(raw, &many); err == nil {
+		return many
+	}
+
+	return nil
+}
+
+func decodeSegment(seg string, out any) error {
+	data, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return fmt.Errorf("%w: malformed segment", constants.ErrInvalidToken)
+	}
+
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("%w: malformed JSON", constants.ErrInvalidToken)
+	}
+
+	return nil
+}
+
+// verifySignature checks the JWS signature for the supported algorithms.
+func verifySignature(alg string, key crypto.PublicKey, signingInput string, sig []byte) error {
+	switch alg {
+	case "RS256", "RS384", "RS512", "PS256", "PS384", "PS512":
+		pub, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("%w: %s with non-RSA key", constants.ErrUnsupportedAlgorithm, alg)
+		}
+
+		h, digest := hashFor(alg[2:], signingInput)
+
+		var err error
+		if alg[0] == 'P' {
+			err = rsa.VerifyPSS(pub, h, digest, sig, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		} else {
+			err = rsa.VerifyPKCS1v15(pub, h, digest, sig)
+		}
+
+		if err != nil {
+			return fmt.Errorf("%w: bad signature", constants.ErrInvalidToken)
+		}
+
+		return nil
+
+	case "ES256", "ES384", "ES512":
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("%w: %s with non-EC key", constants.ErrUnsupportedAlgorithm, alg)
+		}
+
+		size := (pub.Curve.Params().BitSize + constants.Num7) / constants.Num8
+		if len(sig) != constants.Num2*size {
+			return fmt.Errorf("%w: bad signature length", constants.ErrInvalidToken)
+		}
+
+		_, digest := hashFor(alg[2:], signingInput)
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+
+		if !ecdsa.Verify(pub, digest, r, s) {
+			return fmt.Errorf("%w: bad signature", constants.ErrInvalidToken)
+		}
+
+		return nil
+
+	case "EdDSA":
+		pub, ok := key.(ed25519.PublicKey)
+		if !ok {
+			return fmt.Errorf("%w: %s with non-Ed25519 key", constants.ErrUnsupportedAlgorithm, alg)
+		}
+
+		if !ed25519.Verify(pub, []byte(signingInput), sig) {
+			return fmt.Errorf("%w: bad signature", constants.ErrInvalidToken)
+		}
+
+		return nil
+
+	default:
+		return fmt.Errorf("%w: %q", constants.ErrUnsupportedAlgorithm, alg)
+	}
+}
+
+// hashFor returns the hash function and digest for "256", "384" or "512".
+func hashFor(bits, input string) (crypto.Hash, []byte) {
+	var (
+		h  crypto.Hash
+		hh hash.Hash
+	)
+
+	switch bits {
+	case "384":
+		h, hh = crypto.SHA384, sha512.New384()
+	case "512":
+		h, hh = crypto.SHA512, sha512.New()
+	default:
+		h, hh = crypto.SHA256, sha256.New()
+	}
+
+	hh.Write([]byte(input))
+
+	return h, hh.Sum(nil)
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// parseJWKS decodes the RSA, EC and Ed25519 signing keys of a JWKS document.
+func parseJWKS(data []byte) (map[string]crypto.PublicKey, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("%w: %w", constants.ErrInvalidJWKS, err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		key, err := k.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("%w: key %q: %w", constants.ErrInvalidJWKS, k.Kid, err)
+		}
+
+		keys[k.Kid] = key
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w: no signing keys", constants.ErrInvalidJWKS)
+	}
+
+	return keys, nil
+}
+
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid Ed25519 key length %d", len(x))
+		}
+
+		return ed25519.PublicKey(x), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+// ResourceMetadata is the OAuth 2.0 Protected Resource Metadata (RFC 9728)
+// published so MCP clients can discover the authorization server.
+type ResourceMetadata struct {
+	Resource               string   `json:"resource"`
+	AuthorizationServers   []string `json:"authorization_servers,omitempty"`
+	ScopesSupported        []string `json:"scopes_supported,omitempty"`
+	BearerMethodsSupported []string `json:"bearer_methods_supported"`
+}
+
+// MetadataPath is the well-known path of the protected resource metadata document.
+const MetadataPath = "/.well-known/oauth-protected-resource"
+
+// Middleware rejects requests without a valid bearer token and stores the
+// authenticated principal in the request context for the MCP handlers.
+func Middleware(next http.Handler, a Authenticator, metadataURL string, logger *slog.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := bearerToken(r)
+		if !ok {
+			logger.Warn("Rejected unauthenticated request", slog.String("path", r.URL.Path))
+			unauthorized(w, metadataURL, "")
+
+			return
+		}
+
+		p, err := a.Authenticate(r.Context(), token)
+		if err != nil {
+			logger.Warn("Rejected invalid bearer token",
+				slog.String("path", r.URL.Path), slog.String("error", err.Error()))
+			unauthorized(w, metadataURL, "invalid_token")
+
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(WithPrincipal(r.Context(), p)))
+	})
+}
+
+// MetadataHandler serves the protected resource metadata document.
+func MetadataHandler(md ResourceMetadata) http.Handler {
+	if len(md.BearerMethodsSupported) == 0 {
+		md.BearerMethodsSupported = []string{"header"}
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(md)
+	})
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, found := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+
+	return token, token != ""
+}
+
+func unauthorized(w http.ResponseWriter, metadataURL, errCode string) {
+	challenge := `Bearer realm="tazapay-mcp-server"`
+	if errCode != "" {
+		challenge += fmt.Sprintf(", error=%q", errCode)
+	}
+
+	if metadataURL != "" {
+		challenge += fmt.Sprintf(", resource_metadata=%q", metadataURL)
+	}
+
+	w.Header().Set("WWW-Authenticate", challenge)
+	http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+}
@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+)
+
+// StaticTokens authenticates callers against a fixed list of API tokens.
+type StaticTokens struct {
+	entries []staticToken
+}
+
+type staticToken struct {
+	name string
+	hash [sha256.Size]byte
+}
+
+// NewStaticTokens builds an authenticator from entries of the form "name:token" or "token".
+// Unnamed tokens are identified as "token-<n>" in logs and audit records. The name
+// ends at the first colon, so a token containing a colon must be named.
+func NewStaticTokens(entries []string) (*StaticTokens, error) {
+	st := &StaticTokens{}
+
+	for i, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, token, found := strings.Cut(entry, ":")
+		if !found {
+			name, token = fmt.Sprintf("token-%d", i+1), entry
+		}
+
+		if name == "" || token == "" {
+			return nil, fmt.Errorf("%w: entry %d", constants.ErrInvalidStaticToken, i+1)
+		}
+
+		st.entries = append(st.entries, staticToken{name: name, hash: sha256.Sum256([]byte(token))})
+	}
+
+	return st, nil
+}
+
+// Len returns the number of configured tokens.
+func (s *StaticTokens) Len() int {
+	return len(s.entries)
+}
+
+// Authenticate implements Authenticator using constant-time comparison.
+func (s *StaticTokens) Authenticate(_ context.Context, token string) (*Principal, error) {
+	sum := sha256.Sum256([]byte(token))
+
+	var match *staticToken
+
+	for i := range s.entries {
+		if subtle.ConstantTimeCompare(sum[:], s.entries[i].hash[:]) == 1 {
+			match = &s.entries[i]
+		}
+	}
+
+	if match == nil {
+		return nil, constants.ErrInvalidToken
+	}
+
+	return &Principal{Subject: match.name, Method: MethodToken}, nil
+}
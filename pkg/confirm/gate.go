@@ -0,0 +1,208 @@
+package confirm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// Gate holds back configured tools until the user has confirmed a preview of the call.
+//
+// A gated call without a token is not executed. If the client supports
+// elicitation the user is asked directly; otherwise the call returns the
+// preview and a confirmation token, and the model has to repeat the call with
+// that token once the user agrees.
+type Gate struct {
+	server *server.MCPServer
+	store  *Store
+	tools  map[string]bool
+	logger *slog.Logger
+}
+
+// Result is the structured content of a call held back by the gate.
+type Result struct {
+	ConfirmationRequired bool   `json:"confirmation_required"`
+	Cancelled            bool   `json:"cancelled,omitempty"`
+	Preview              string `json:"preview"`
+	ConfirmationToken    string `json:"confirmation_token,omitempty"`
+	ExpiresAt            string `json:"expires_at,omitempty"`
+}
+
+// NewGate returns a Gate for the named tools. s is used for elicitation and may be nil.
+func NewGate(s *server.MCPServer, store *Store, tools []string, logger *slog.Logger) *Gate {
+	g := &Gate{server: s, store: store, tools: make(map[string]bool, len(tools)), logger: logger}
+
+	for _, name := range tools {
+		g.tools[name] = true
+	}
+
+	return g
+}
+
+// Requires reports whether calls to the named tool need confirmation.
+func (g *Gate) Requires(name string) bool {
+	return g != nil && g.tools[name]
+}
+
+// Decorate adds the confirmation token parameter to the definition of a gated tool.
+func (g *Gate) Decorate(def mcp.Tool) mcp.Tool {
+	if !g.Requires(def.Name) {
+		return def
+	}
+
+	mcp.WithString(constants.ConfirmationTokenField, mcp.Description(constants.ConfirmationTokenDesc))(&def)
+	def.Description = strings.TrimSuffix(def.Description, ".") + ". Requires user confirmation: the first call only returns a preview"
+
+	// A gated call returns either the tool's own output or a Result, so the
+	// output schema has to accept both
+	if def.OutputSchema.Type != "" {
+		def.OutputSchema.Required = nil
+		def.OutputSchema.Properties["confirmation_required"] = map[string]any{"type": "boolean"}
+		def.OutputSchema.Properties["cancelled"] = map[string]any{"type": "boolean"}
+		def.OutputSchema.Properties["preview"] = map[string]any{"type": "string"}
+		def.OutputSchema.Properties[constants.ConfirmationTokenField] = map[string]any{"type": "string"}
+		def.OutputSchema.Properties["expires_at"] = map[string]any{"type": "string"}
+	}
+
+	return def
+}
+
+// Check decides whether a call may run. It returns a nil result when the tool
+// should execute, or the result to return instead (a preview or a cancellation).
+func (g *Gate) Check(ctx context.Context, tool types.Tool, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	name := req.Params.Name
+	if !g.Requires(name) {
+		return nil, nil
+	}
+
+	args := req.GetArguments()
+	digest := Digest(ctx, name, args)
+
+	if token, _ := args[constants.ConfirmationTokenField].(string); token != "" {
+		if err := g.store.Redeem(token, name, digest); err != nil {
+			g.logger.Warn("confirmation rejected", slog.String("tool", name), slog.String("error", err.Error()))
+			return nil, err
+		}
+
+		g.logger.Info("confirmation accepted", slog.String("tool", name))
+
+		return nil, nil
+	}
+
+	// Invalid arguments fail here, before the user is asked to confirm anything
+	preview, err := g.preview(ctx, tool, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if g.supportsElicitation(ctx) {
+		var confirmed bool
+
+		confirmed, err = g.elicit(ctx, preview)
+		if err == nil {
+			if confirmed {
+				g.logger.Info("call confirmed by user", slog.String("tool", name))
+				return nil, nil
+			}
+
+			g.logger.Info("call declined by user", slog.String("tool", name))
+
+			return mcp.NewToolResultStructured(Result{Cancelled: true, Preview: preview},
+				"Cancelled: the user did not confirm this action. Nothing was executed."), nil
+		}
+
+		g.logger.Warn("elicitation failed, falling back to confirmation token",
+			slog.String("tool", name), slog.String("error", err.Error()))
+	}
+
+	token, expires, err := g.store.Issue(name, digest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue confirmation token: %w", err)
+	}
+
+	g.logger.Info("confirmation required", slog.String("tool", name))
+
+	result := Result{
+		ConfirmationRequired: true,
+		Preview:              preview,
+		ConfirmationToken:    token,
+		ExpiresAt:            expires.UTC().Format(time.RFC3339),
+	}
+
+	return mcp.NewToolResultStructured(result, fmt.Sprintf(
+		"CONFIRMATION REQUIRED. Nothing has been executed yet.\n\n%s\n\n"+
+			"Show this preview to the user. Only if they explicitly confirm, call %s again with the same"+
+			" arguments and %s=%q. The token expires at %s.",
+		preview, name, constants.ConfirmationTokenField, token, result.ExpiresAt,
+	)), nil
+}
+
+// preview describes the call, using the tool's own preview when it has one.
+func (*Gate) preview(ctx context.Context, tool types.Tool, req mcp.CallToolRequest) (string, error) {
+	if p, ok := tool.(types.Previewer); ok {
+		return p.Preview(ctx, req)
+	}
+
+	args, err := json.MarshalIndent(WithoutToken(req.GetArguments()), "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("Call %s with arguments:\n%s", req.Params.Name, args), nil
+}
+
+// supportsElicitation reports whether the calling client declared the elicitation capability.
+func (g *Gate) supportsElicitation(ctx context.Context) bool {
+	if g.server == nil {
+		return false
+	}
+
+	session, ok := server.ClientSessionFromContext(ctx).(server.SessionWithClientInfo)
+
+	return ok && session.GetClientCapabilities().Elicitation != nil
+}
+
+// elicit asks the user to confirm the preview.
+func (g *Gate) elicit(ctx context.Context, preview string) (bool, error) {
+	res, err := g.server.RequestElicitation(ctx, mcp.ElicitationRequest{
+		Params: mcp.ElicitationParams{
+			Message: preview + "\n\nDo you want to proceed?",
+			RequestedSchema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					constants.ConfirmField: map[string]any{
+						"type":        "boolean",
+						"title":       "Confirm",
+						"description": "Execute this action",
+					},
+				},
+				"required": []string{constants.ConfirmField},
+			},
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if res == nil {
+		return false, constants.ErrNoDataInResponse
+	}
+
+	if res.Action != mcp.ElicitationResponseActionAccept {
+		return false, nil
+	}
+
+	content, _ := res.Content.(map[string]any)
+	confirmed, _ := content[constants.ConfirmField].(bool)
+
+	return confirmed, nil
+}
@@ -0,0 +1,119 @@
+// Package confirm implements the human confirmation gate for tools that move money.
+package confirm
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+)
+
+// Store keeps short-lived, single-use confirmation tokens in memory.
+type Store struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	pending map[string]pending
+	now     func() time.Time
+}
+
+type pending struct {
+	tool    string
+	digest  string
+	expires time.Time
+}
+
+// NewStore returns a Store whose tokens expire after ttl.
+func NewStore(ttl time.Duration) *Store {
+	if ttl <= 0 {
+		ttl = constants.DefaultConfirmTTL
+	}
+
+	return &Store{ttl: ttl, pending: make(map[string]pending), now: time.Now}
+}
+
+// TTL returns how long issued tokens stay valid.
+func (s *Store) TTL() time.Duration {
+	return s.ttl
+}
+
+// Issue returns a new token bound to the tool and the digest of its arguments.
+func (s *Store) Issue(tool, digest string) (string, time.Time, error) {
+	b := make([]byte, constants.ConfirmTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", time.Time{}, err
+	}
+
+	token := constants.ConfirmTokenPrefix + hex.EncodeToString(b)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+
+	// Drop expired tokens so abandoned previews do not accumulate
+	maps.DeleteFunc(s.pending, func(_ string, p pending) bool { return now.After(p.expires) })
+
+	expires := now.Add(s.ttl)
+	s.pending[token] = pending{tool: tool, digest: digest, expires: expires}
+
+	return token, expires, nil
+}
+
+// Redeem consumes token. It succeeds only if the token was issued for the same
+// tool and arguments and has not expired. A token can be redeemed once, even
+// when redemption fails.
+func (s *Store) Redeem(token, tool, digest string) error {
+	s.mu.Lock()
+	p, ok := s.pending[token]
+	delete(s.pending, token)
+	s.mu.Unlock()
+
+	switch {
+	case !ok:
+		return constants.ErrConfirmationInvalid
+
+	case s.now().After(p.expires):
+		return constants.ErrConfirmationExpired
+
+	case p.tool != tool || p.digest != digest:
+		return constants.ErrConfirmationMismatch
+
+	default:
+		return nil
+	}
+}
+
+// Digest identifies a tool call by session, tool name and arguments,
+// ignoring the confirmation token itself.
+func Digest(ctx context.Context, tool string, args map[string]any) string {
+	sessionID := ""
+	if session := server.ClientSessionFromContext(ctx); session != nil {
+		sessionID = session.SessionID()
+	}
+
+	// json.Marshal sorts map keys, giving a canonical encoding of the arguments
+	data, err := json.Marshal(WithoutToken(args))
+	if err != nil {
+		data = nil
+	}
+
+	sum := sha256.Sum256([]byte(sessionID + "|" + tool + "|" + string(data)))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// WithoutToken returns a copy of args without the confirmation token.
+func WithoutToken(args map[string]any) map[string]any {
+	out := maps.Clone(args)
+	delete(out, constants.ConfirmationTokenField)
+
+	return out
+}
@@ -0,0 +1,64 @@
+package confirm_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/confirm"
+)
+
+func TestStoreRedeem(t *testing.T) {
+	args := map[string]any{"amount": 10.5, "currency": "USD"}
+	digest := confirm.Digest(t.Context(), "refund", args)
+
+	withToken := map[string]any{"amount": 10.5, "currency": "USD", constants.ConfirmationTokenField: "cnf_x"}
+	if confirm.Digest(t.Context(), "refund", withToken) != digest {
+		t.Fatalf("expected the confirmation token to be ignored by Digest")
+	}
+
+	s := confirm.NewStore(time.Minute)
+
+	token, _, err := s.Issue("refund", digest)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if err := s.Redeem(token, "refund", digest); err != nil {
+		t.Fatalf("expected redeem to succeed, got: %v", err)
+	}
+
+	if err := s.Redeem(token, "refund", digest); !errors.Is(err, constants.ErrConfirmationInvalid) {
+		t.Errorf("expected a token to be single-use, got: %v", err)
+	}
+}
+
+func TestStoreRejectsChangedArguments(t *testing.T) {
+	s := confirm.NewStore(time.Minute)
+
+	token, _, err := s.Issue("refund", confirm.Digest(t.Context(), "refund", map[string]any{"amount": 10.0}))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	err = s.Redeem(token, "refund", confirm.Digest(t.Context(), "refund", map[string]any{"amount": 1000.0}))
+	if !errors.Is(err, constants.ErrConfirmationMismatch) {
+		t.Errorf("expected mismatch, got: %v", err)
+	}
+}
+
+func TestStoreExpiry(t *testing.T) {
+	s := confirm.NewStore(time.Millisecond)
+
+	token, _, err := s.Issue("payout", "digest")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	time.Sleep(5 * time.Millisecond)
+
+	if err := s.Redeem(token, "payout", "digest"); !errors.Is(err, constants.ErrConfirmationExpired) {
+		t.Errorf("expected expiry, got: %v", err)
+	}
+}
@@ -0,0 +1,155 @@
+// Package decline explains payment decline codes in plain language, so an
+// agent can tell a customer why a payment failed and what to do next.
+package decline
+
+import "strings"
+
+// Explanation describes a decline code.
+type Explanation struct {
+	Code      string `json:"code"`
+	Known     bool   `json:"known"`     // False when the code is not in the table and the text is generic
+	Reason    string `json:"reason"`    // What happened
+	Action    string `json:"action"`    // What the customer should do
+	Retryable bool   `json:"retryable"` // Whether retrying the same payment method may succeed
+}
+
+// Text returns the explanation as a sentence pair.
+func (e Explanation) Text() string {
+	return e.Reason + " " + e.Action
+}
+
+// Actions shared by several codes
+const (
+	contactBank     = "Ask the customer to contact their bank, or to pay with another card or payment method."
+	otherMethod     = "Ask the customer to pay with another card or payment method."
+	checkDetails    = "Ask the customer to check the details they entered and try again."
+	retryLater      = "Ask the customer to try again in a few minutes."
+	doNotRetry      = "Do not ask the customer to retry with this card; they should contact their bank."
+	restartCheckout = "Create a new payment link if the customer still wants to pay."
+)
+
+// codes maps normalized decline codes to their explanation.
+var codes = map[string]Explanation{
+	"insufficient_funds": {Reason: "The card or account did not have enough funds.", Action: otherMethod, Retryable: true},
+	"do_not_honor": {
+		Reason: "The bank declined the payment without giving a reason.", Action: contactBank,
+	},
+	"generic_decline": {Reason: "The bank declined the payment without giving a reason.", Action: contactBank},
+	"card_declined":   {Reason: "The bank declined the payment without giving a reason.", Action: contactBank},
+	"expired_card":    {Reason: "The card has expired.", Action: otherMethod},
+	"incorrect_cvc":   {Reason: "The card's security code (CVC) was incorrect.", Action: checkDetails, Retryable: true},
+	"incorrect_number": {
+		Reason: "The card number was incorrect.", Action: checkDetails, Retryable: true,
+	},
+	"invalid_expiry_date": {Reason: "The card's expiry date was incorrect.", Action: checkDetails, Retryable: true},
+	"incorrect_zip": {
+		Reason: "The billing postal code did not match the card.", Action: checkDetails, Retryable: true,
+	},
+	"lost_card":   {Reason: "The card was reported lost.", Action: doNotRetry},
+	"stolen_card": {Reason: "The card was reported stolen.", Action: doNotRetry},
+	"pickup_card": {Reason: "The bank asked for the card to be retained, usually because it was blocked.", Action: doNotRetry},
+	"fraudulent": {
+		Reason: "The payment was flagged as likely fraud by the bank or by risk checks.", Action: doNotRetry,
+	},
+	"restricted_card": {Reason: "The card cannot be used for this kind of payment.", Action: contactBank},
+	"card_not_supported": {
+		Reason: "The card brand or type is not accepted for this payment.", Action: otherMethod,
+	},
+	"currency_not_supported": {Reason: "The card cannot be charged in the invoice currency.", Action: otherMethod},
+	"transaction_not_allowed": {
+		Reason: "The bank does not allow this kind of transaction on the card, often online or international payments.",
+		Action: contactBank,
+	},
+	"card_velocity_exceeded": {
+		Reason: "The card's spending or transaction limit was reached.", Action: contactBank, Retryable: true,
+	},
+	"withdrawal_count_limit_exceeded": {
+		Reason: "The card's transaction limit was reached.", Action: contactBank, Retryable: true,
+	},
+	"authentication_required": {
+		Reason: "The bank required 3-D Secure authentication, which was not completed.",
+		Action: "Ask the customer to try again and complete the verification step from their bank.", Retryable: true,
+	},
+	"authentication_failed": {
+		Reason: "The customer failed the bank's 3-D Secure verification.",
+		Action: "Ask the customer to try again and complete the verification step from their bank.", Retryable: true,
+	},
+	"issuer_not_available": {
+		Reason: "The bank could not be reached to authorize the payment.", Action: retryLater, Retryable: true,
+	},
+	"processing_error": {Reason: "An error occurred while processing the payment.", Action: retryLater, Retryable: true},
+	"try_again_later":  {Reason: "The bank asked for the payment to be retried later.", Action: retryLater, Retryable: true},
+	"duplicate_transaction": {
+		Reason: "An identical payment was submitted moments before.",
+		Action: "Check whether an earlier attempt succeeded before asking the customer to pay again.",
+	},
+	"payment_method_expired": {
+		Reason: "The customer did not complete the payment in time, e.g. a bank transfer or QR code expired.",
+		Action: restartCheckout, Retryable: true,
+	},
+	"cancelled_by_customer": {Reason: "The customer cancelled the payment.", Action: restartCheckout, Retryable: true},
+}
+
+// aliases maps other names for a decline, including ISO 8583 response codes, to the codes above.
+var aliases = map[string]string{
+	"05": "do_not_honor",
+	"14": "incorrect_number",
+	"41": "lost_card",
+	"43": "stolen_card",
+	"51": "insufficient_funds",
+	"54": "expired_card",
+	"57": "transaction_not_allowed",
+	"59": "fraudulent",
+	"61": "card_velocity_exceeded",
+	"65": "withdrawal_count_limit_exceeded",
+	"82": "incorrect_cvc",
+	"91": "issuer_not_available",
+	"n7": "incorrect_cvc",
+
+	"declined":              "generic_decline",
+	"invalid_cvc":           "incorrect_cvc",
+	"invalid_number":        "incorrect_number",
+	"invalid_card_number":   "incorrect_number",
+	"expired":               "expired_card",
+	"fraud":                 "fraudulent",
+	"suspected_fraud":       "fraudulent",
+	"three_d_secure_failed": "authentication_failed",
+	"3ds_failed":            "authentication_failed",
+	"3ds_required":          "authentication_required",
+	"expired_payment":       "payment_method_expired",
+	"timeout":               "payment_method_expired",
+	"cancelled":             "cancelled_by_customer",
+	"canceled":              "cancelled_by_customer",
+}
+
+// Normalize lowercases a code and joins its words with underscores.
+func Normalize(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+
+	return strings.Join(strings.FieldsFunc(code, func(r rune) bool {
+		return r == ' ' || r == '-' || r == '_' || r == '.'
+	}), "_")
+}
+
+// Explain returns the explanation of code. Unknown codes get a generic
+// explanation with Known set to false.
+func Explain(code string) Explanation {
+	normalized := Normalize(code)
+	if alias, ok := aliases[normalized]; ok {
+		normalized = alias
+	}
+
+	e, ok := codes[normalized]
+	if !ok {
+		return Explanation{
+			Code:   code,
+			Reason: "The payment was declined with a code that has no standard explanation.",
+			Action: contactBank,
+		}
+	}
+
+	e.Code = normalized
+	e.Known = true
+
+	return e
+}
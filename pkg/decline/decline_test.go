@@ -0,0 +1,36 @@
+package decline_test
+
+import (
+	"testing"
+
+	"github.com/tazapay/tazapay-mcp-server/pkg/decline"
+)
+
+func TestExplain(t *testing.T) {
+	tests := []struct {
+		code      string
+		want      string
+		known     bool
+		retryable bool
+	}{
+		{"insufficient_funds", "insufficient_funds", true, true},
+		{" Insufficient Funds ", "insufficient_funds", true, true},
+		{"do-not-honor", "do_not_honor", true, false},
+		{"51", "insufficient_funds", true, true},
+		{"N7", "incorrect_cvc", true, true},
+		{"stolen_card", "stolen_card", true, false},
+		{"xyz_unknown", "xyz_unknown", false, false},
+	}
+
+	for _, tt := range tests {
+		e := decline.Explain(tt.code)
+
+		if e.Code != tt.want || e.Known != tt.known || e.Retryable != tt.retryable {
+			t.Errorf("Explain(%q) = %+v, want code %s, known %v, retryable %v", tt.code, e, tt.want, tt.known, tt.retryable)
+		}
+
+		if e.Reason == "" || e.Action == "" {
+			t.Errorf("Explain(%q) has an empty reason or action: %+v", tt.code, e)
+		}
+	}
+}
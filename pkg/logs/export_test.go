@@ -0,0 +1,18 @@
+package log
+
+import (
+	"io"
+	"time"
+)
+
+// OpenRotatingFile exposes openRotatingFile to the tests, with a clock.
+func OpenRotatingFile(path string, cfg Config, now func() time.Time) (io.WriteCloser, error) {
+	f, err := openRotatingFile(path, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	f.now = now
+
+	return f, nil
+}
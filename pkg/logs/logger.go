@@ -3,19 +3,32 @@ package log
 import (
 	"context"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/tazapay/tazapay-mcp-server/constants"
 )
 
 // Config represents the logger configuration.
 type Config struct {
-	FilePath string // Custom file path; if empty, uses default
-	Format   string // "text" or "json"; defaults to "text"
-	Level    string // "debug", "info", "warn", "error"; defaults to "info"
+	FilePath string         // Custom file path, whose directory must exist; if empty, uses default
+	Format   string         // "text" or "json"; defaults to "text"
+	Level    string         // "debug", "info", "warn" or "warning", "error"; defaults to "info"
+	LevelVar *slog.LevelVar // Optional; set to Level and used as the level, so it can be changed at runtime
+	Stderr   bool           // Also write logs to stderr
+
+	MaxSizeMB  int           // Rotate the file when it grows past this size; 0 disables
+	MaxAge     time.Duration // Rotate the file when it is older than this; 0 disables
+	MaxBackups int           // Rotated files to keep; 0 keeps all
+	Retention  time.Duration // Delete rotated files older than this; 0 keeps them
+
+	DisableRedaction bool   // Log values as they are; redaction is on by default
+	RedactRules      []Rule // Applied after DefaultRules, so they can override them
+	MaxValueLength   int    // Cap on logged strings; defaults to constants.DefaultLogMaxValueLength
 }
 
 // getDefaultLogPath returns a fallback log path near the executable.
@@ -29,30 +42,31 @@ func getDefaultLogPath() string {
 	return filepath.Join(execDir, "logs", "tazapay-mcp-server.log")
 }
 
-// New creates a structured logger based on the given config.
-func New(cfg Config) (*slog.Logger, func(ctx context.Context), error) {
+// New creates a structured logger based on the given config. When the log
+// file cannot be opened, it warns on stderr and logs to stderr instead, so a
+// bad path does not stop the server.
+func New(cfg Config) (*slog.Logger, func(ctx context.Context)) {
 	logPath := cfg.FilePath
 	if logPath == "" {
 		logPath = getDefaultLogPath()
-	}
 
-	// Ensure the log directory exists.
-	logDir := filepath.Dir(logPath)
-	if err := os.MkdirAll(logDir, os.ModePerm); err != nil {
-		return nil, nil, fmt.Errorf("failed to create log directory: %v", err)
+		// Only the default directory is created; a custom path with a typo should not create a tree
+		if err := os.MkdirAll(filepath.Dir(logPath), os.ModePerm); err != nil {
+			return fallbackLogger(cfg, fmt.Errorf("failed to create log directory: %w", err)), func(context.Context) {}
+		}
 	}
 
-	file, err := os.OpenFile(logPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, constants.OpenFileMode)
+	file, err := openRotatingFile(logPath, cfg)
 	if err != nil {
-		// Still allowed to use Fprintf on stderr if logger isn't ready
-		fmt.Fprintf(os.Stderr,
-			"Warning: Failed to open log file: %v\nFalling back to stderr\n", err)
+		return fallbackLogger(cfg, fmt.Errorf("failed to open log file: %w", err)), func(context.Context) {}
+	}
 
-		return fallbackLogger(cfg), func(context.Context) {}, err
+	var out io.Writer = file
+	if cfg.Stderr {
+		out = io.MultiWriter(file, os.Stderr)
 	}
 
-	handler := getHandler(cfg, file)
-	logger := slog.New(handler)
+	logger := slog.New(getHandler(cfg, out))
 
 	cleanup := func(ctx context.Context) {
 		if err := file.Close(); err != nil {
@@ -63,44 +77,100 @@ func New(cfg Config) (*slog.Logger, func(ctx context.Context), error) {
 	// Using InfoContext with a background context
 	logger.InfoContext(context.Background(), "Logs are stored in", "path", logPath)
 
-	return logger, cleanup, nil
+	return logger, cleanup
 }
 
+// fallbackLogger warns about err and returns a stderr-based logger.
+func fallbackLogger(cfg Config, err error) *slog.Logger {
+	// Still allowed to use Fprintf on stderr if logger isn't ready
+	fmt.Fprintf(os.Stderr, "Warning: %v\nFalling back to stderr\n", err)
 
-// fallbackLogger returns a stderr-based logger if file init fails.
-func fallbackLogger(cfg Config) *slog.Logger {
-	handler := getHandler(cfg, os.Stderr)
-	return slog.New(handler)
+	return slog.New(getHandler(cfg, os.Stderr))
 }
 
-// getHandler creates the appropriate slog handler.
-func getHandler(cfg Config, out *os.File) slog.Handler {
+// getHandler creates the appropriate slog handler, redacting unless disabled.
+func getHandler(cfg Config, out io.Writer) slog.Handler {
+	var level slog.Leveler = parseLogLevel(cfg.Level)
+	if cfg.LevelVar != nil {
+		cfg.LevelVar.Set(parseLogLevel(cfg.Level))
+		level = cfg.LevelVar
+	}
+
 	opts := &slog.HandlerOptions{
-		Level: parseLogLevel(cfg.Level),
+		Level: level,
 	}
 
+	var handler slog.Handler
+
 	switch strings.ToLower(cfg.Format) {
 	case "json":
-		return slog.NewJSONHandler(out, opts)
+		handler = slog.NewJSONHandler(out, opts)
 
 	default:
-		return slog.NewTextHandler(out, opts)
+		handler = slog.NewTextHandler(out, opts)
+	}
+
+	if cfg.DisableRedaction {
+		return handler
 	}
+
+	return NewRedactingHandler(handler, append(DefaultRules(), cfg.RedactRules...), cfg.MaxValueLength)
 }
 
-// parseLogLevel converts a string level to slog.Level.
+// parseLogLevel converts a string level to slog.Level, defaulting to info.
 func parseLogLevel(level string) slog.Level {
+	l, _ := ParseLevel(level)
+
+	return l
+}
+
+// ParseLevel parses a LOG_LEVEL, ignoring case: debug, info, warn or error,
+// or warning, as MCP spells it.
+func ParseLevel(level string) (slog.Level, error) {
 	switch strings.ToLower(level) {
 	case "debug":
-		return slog.LevelDebug
+		return slog.LevelDebug, nil
 
-	case "warn":
-		return slog.LevelWarn
+	case "info":
+		return slog.LevelInfo, nil
+
+	case "warn", "warning":
+		return slog.LevelWarn, nil
 
 	case "error":
-		return slog.LevelError
+		return slog.LevelError, nil
 
 	default:
+		return slog.LevelInfo, fmt.Errorf("%w: %q", constants.ErrInvalidLogLevel, level)
+	}
+}
+
+// CheckFormat reports an error unless format is a LOG_FORMAT the logger
+// writes, json or text, ignoring case.
+func CheckFormat(format string) error {
+	switch strings.ToLower(format) {
+	case "json", "text":
+		return nil
+
+	default:
+		return fmt.Errorf("%w: %q", constants.ErrInvalidLogFormat, format)
+	}
+}
+
+// MCPLevel converts a level of the MCP logging/setLevel request to slog.Level.
+// MCP has the eight syslog severities; those above error map to error.
+func MCPLevel(level string) slog.Level {
+	switch level {
+	case "debug":
+		return slog.LevelDebug
+
+	case "info", "notice":
 		return slog.LevelInfo
+
+	case "warning":
+		return slog.LevelWarn
+
+	default:
+		return slog.LevelError
 	}
 }
@@ -2,20 +2,21 @@ package log_test
 
 import (
 	"bytes"
+	"errors"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/tazapay/tazapay-mcp-server/constants"
 	log "github.com/tazapay/tazapay-mcp-server/pkg/logs"
 )
 
 func TestNewLoggerWithDefaultConfig(t *testing.T) {
 	cfg := log.Config{}
-	logger, closeFn, err := log.New(cfg)
-	if err != nil {
-		t.Fatalf("expected no error, got: %v", err)
-	}
+	logger, closeFn := log.New(cfg)
 	defer closeFn(t.Context())
 
 	logger.InfoContext(t.Context(), "default logger test")
@@ -31,10 +32,7 @@ func TestNewLoggerWithCustomConfig(t *testing.T) {
 		Level:    "debug",
 	}
 
-	logger, closeFn, err := log.New(cfg)
-	if err != nil {
-		t.Fatalf("expected no error, got: %v", err)
-	}
+	logger, closeFn := log.New(cfg)
 	defer closeFn(t.Context())
 
 	ctx := t.Context()
@@ -64,10 +62,7 @@ func TestNewLoggerWithInvalidFilePath(t *testing.T) {
 		Level:    "info",
 	}
 
-	logger, closeFn, err := log.New(cfg)
-	if err != nil {
-		t.Fatalf("expected fallback logger without error, got: %v", err)
-	}
+	logger, closeFn := log.New(cfg)
 	defer closeFn(t.Context())
 
 	logger.InfoContext(t.Context(), "fallback log test")
@@ -77,8 +72,7 @@ func TestNewLoggerWithInvalidFilePath(t *testing.T) {
 	os.Stderr = oldStderr
 
 	var buf bytes.Buffer
-	_, err = buf.ReadFrom(r)
-	if err != nil {
+	if _, err := buf.ReadFrom(r); err != nil {
 		t.Fatalf("failed to read from stderr pipe: %v", err)
 	}
 
@@ -86,3 +80,98 @@ func TestNewLoggerWithInvalidFilePath(t *testing.T) {
 		t.Errorf("expected fallback warning in stderr, got: %s", buf.String())
 	}
 }
+
+func TestNewLoggerRotatesBySize(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	cfg := log.Config{
+		FilePath:   filepath.Join(tmpDir, "rotating.log"),
+		Format:     "json",
+		MaxSizeMB:  1,
+		MaxBackups: 2,
+	}
+
+	logger, closeFn := log.New(cfg)
+	defer closeFn(t.Context())
+
+	// About 4 MB, enough for 3 rotations
+	line := strings.Repeat("x", 1000)
+	for range 4000 {
+		logger.InfoContext(t.Context(), line)
+	}
+
+	entries, err := os.ReadDir(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to read log directory: %v", err)
+	}
+
+	if len(entries) != 3 {
+		t.Fatalf("expected the log file and 2 rotated files, got: %v", entries)
+	}
+
+	for _, e := range entries {
+		info, err := e.Info()
+		if err != nil || info.Size() > 1<<20 {
+			t.Errorf("expected %s to stay within 1 MB, got: %v", e.Name(), info)
+		}
+	}
+}
+
+func TestRotationsInTheSameMillisecondKeepEveryBackup(t *testing.T) {
+	tmpDir := t.TempDir()
+	path := filepath.Join(tmpDir, "rotating.log")
+	at := time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC)
+
+	f, err := log.OpenRotatingFile(path, log.Config{MaxSizeMB: 1, MaxBackups: 5}, func() time.Time { return at })
+	if err != nil {
+		t.Fatalf("failed to open log file: %v", err)
+	}
+	defer f.Close()
+
+	// Each chunk fills the file, so every write after the first rotates it
+	for _, c := range "abcd" {
+		if _, err := f.Write(bytes.Repeat([]byte{byte(c)}, 1<<20)); err != nil {
+			t.Fatalf("failed to write: %v", err)
+		}
+	}
+
+	entries, err := os.ReadDir(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to read log directory: %v", err)
+	}
+
+	if len(entries) != 4 {
+		t.Fatalf("expected the log file and 3 rotated files, got: %v", entries)
+	}
+
+	seen := map[byte]bool{}
+
+	for _, e := range entries {
+		data, err := os.ReadFile(filepath.Join(tmpDir, e.Name()))
+		if err != nil || len(data) != 1<<20 {
+			t.Fatalf("expected %s to hold one chunk, got %d bytes, %v", e.Name(), len(data), err)
+		}
+
+		seen[data[0]] = true
+	}
+
+	if len(seen) != 4 {
+		t.Errorf("expected every chunk to be kept, got: %v", seen)
+	}
+}
+
+func TestParseLevelAndFormat(t *testing.T) {
+	for level, want := range map[string]slog.Level{"DEBUG": slog.LevelDebug, "warning": slog.LevelWarn, "error": slog.LevelError} {
+		if got, err := log.ParseLevel(level); err != nil || got != want {
+			t.Errorf("ParseLevel(%q) = %v, %v, want %v", level, got, err, want)
+		}
+	}
+
+	if _, err := log.ParseLevel("verbose"); !errors.Is(err, constants.ErrInvalidLogLevel) {
+		t.Errorf("expected ErrInvalidLogLevel, got: %v", err)
+	}
+
+	if err := log.CheckFormat("logfmt"); !errors.Is(err, constants.ErrInvalidLogFormat) {
+		t.Errorf("expected ErrInvalidLogFormat, got: %v", err)
+	}
+}
@@ -0,0 +1,297 @@
+package log
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/redact"
+)
+
+// Rule redacts the attributes, and the fields of logged maps and structs,
+// whose key matches Key, at any depth and ignoring case. A rule with a Scope
+// only applies to the fields of the objects logged under that key.
+type Rule struct {
+	Key    string
+	Action string // One of the constants.Redact* actions
+	Scope  string
+}
+
+// DefaultRules returns the rules applied unless redaction is disabled:
+// secrets are dropped, HTTP bodies truncated, and personal data redacted as
+// in the audit log, by the rules in redact.Fields and redact.Scoped.
+func DefaultRules() []Rule {
+	rules := []Rule{
+		{Key: "body", Action: constants.RedactTruncate},
+		{Key: "response_body", Action: constants.RedactTruncate},
+	}
+
+	for _, key := range []string{
+		"authorization", "api_key", "api_secret", "secret", "password", "token", "access_token",
+		constants.ConfirmationTokenField, "tazapay_auth_token", "tazapay_api_secret", "webhook_secret",
+	} {
+		rules = append(rules, Rule{Key: key, Action: constants.RedactDrop})
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(redact.Fields)) {
+		rules = append(rules, Rule{Key: key, Action: redact.Fields[key]})
+	}
+
+	for _, scope := range slices.Sorted(maps.Keys(redact.Scoped)) {
+		for _, key := range slices.Sorted(maps.Keys(redact.Scoped[scope])) {
+			rules = append(rules, Rule{Key: key, Action: redact.Scoped[scope][key], Scope: scope})
+		}
+	}
+
+	return rules
+}
+
+// ParseRules parses rules written as key:action, e.g. "reference_id:hash".
+func ParseRules(specs []string) ([]Rule, error) {
+	actions := []string{
+		constants.RedactDrop, constants.RedactMask, constants.RedactMaskEmail,
+		constants.RedactHash, constants.RedactLastFour, constants.RedactTruncate,
+	}
+
+	rules := make([]Rule, 0, len(specs))
+
+	for _, spec := range specs {
+		key, action, ok := strings.Cut(spec, ":")
+		key, action = strings.TrimSpace(key), strings.ToLower(strings.TrimSpace(action))
+
+		if !ok || key == "" || !slices.Contains(actions, action) {
+			return nil, fmt.Errorf("%w: %q", constants.ErrInvalidRedactRule, spec)
+		}
+
+		rules = append(rules, Rule{Key: key, Action: action})
+	}
+
+	return rules, nil
+}
+
+// RedactingHandler applies redaction rules to every record before passing it
+// on. Email addresses are also masked in free text such as messages and
+// errors, and every string is capped in length.
+type RedactingHandler struct {
+	next     slog.Handler
+	actions  map[string]string            // Lower-case key to action
+	scoped   map[string]map[string]string // Lower-case scope to lower-case key to action
+	maxValue int
+}
+
+// NewRedactingHandler wraps next with rules; later rules for the same key win.
+// maxValue caps the length of logged strings; zero uses the default.
+func NewRedactingHandler(next slog.Handler, rules []Rule, maxValue int) *RedactingHandler {
+	if maxValue <= 0 {
+		maxValue = constants.DefaultLogMaxValueLength
+	}
+
+	actions := make(map[string]string, len(rules))
+	scoped := make(map[string]map[string]string)
+
+	for _, r := range rules {
+		if r.Scope == "" {
+			actions[strings.ToLower(r.Key)] = r.Action
+			continue
+		}
+
+		scope := strings.ToLower(r.Scope)
+		if scoped[scope] == nil {
+			scoped[scope] = make(map[string]string)
+		}
+
+		scoped[scope][strings.ToLower(r.Key)] = r.Action
+	}
+
+	return &RedactingHandler{next: next, actions: actions, scoped: scoped, maxValue: maxValue}
+}
+
+// Enabled implements slog.Handler.
+func (h *RedactingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler.
+func (h *RedactingHandler) Handle(ctx context.Context, r slog.Record) error {
+	out := slog.NewRecord(r.Time, r.Level, h.text(r.Message), r.PC)
+
+	r.Attrs(func(a slog.Attr) bool {
+		if a, ok := h.attr(a, nil); ok {
+			out.AddAttrs(a)
+		}
+
+		return true
+	})
+
+	return h.next.Handle(ctx, out)
+}
+
+// WithAttrs implements slog.Handler.
+func (h *RedactingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	kept := make([]slog.Attr, 0, len(attrs))
+
+	for _, a := range attrs {
+		if a, ok := h.attr(a, nil); ok {
+			kept = append(kept, a)
+		}
+	}
+
+	return &RedactingHandler{next: h.next.WithAttrs(kept), actions: h.actions, scoped: h.scoped, maxValue: h.maxValue}
+}
+
+// WithGroup implements slog.Handler.
+func (h *RedactingHandler) WithGroup(name string) slog.Handler {
+	return &RedactingHandler{next: h.next.WithGroup(name), actions: h.actions, scoped: h.scoped, maxValue: h.maxValue}
+}
+
+// attr redacts an attribute, with the scoped rules of the group holding it,
+// and reports whether it is kept.
+func (h *RedactingHandler) attr(a slog.Attr, scoped map[string]string) (slog.Attr, bool) {
+	v := a.Value.Resolve()
+
+	if action, ok := h.action(a.Key, scoped); ok {
+		if action == constants.RedactDrop {
+			return slog.Attr{}, false
+		}
+
+		if v.Kind() != slog.KindGroup {
+			return slog.String(a.Key, h.apply(action, v.String())), true
+		}
+	}
+
+	switch v.Kind() {
+	case slog.KindString:
+		return slog.String(a.Key, h.text(v.String())), true
+
+	case slog.KindGroup:
+		var attrs []any
+
+		for _, ga := range v.Group() {
+			if ga, ok := h.attr(ga, h.scoped[strings.ToLower(a.Key)]); ok {
+				attrs = append(attrs, ga)
+			}
+		}
+
+		return slog.Group(a.Key, attrs...), true
+
+	case slog.KindAny:
+		return slog.Any(a.Key, h.any(v.Any(), h.scoped[strings.ToLower(a.Key)])), true
+
+	default:
+		return slog.Attr{Key: a.Key, Value: v}, true
+	}
+}
+
+// action returns the action for a key, looking at the scoped rules of the
+// object holding it first.
+func (h *RedactingHandler) action(key string, scoped map[string]string) (string, bool) {
+	key = strings.ToLower(key)
+
+	if action, ok := scoped[key]; ok {
+		return action, true
+	}
+
+	action, ok := h.actions[key]
+
+	return action, ok
+}
+
+// any redacts a logged value of any type, with the scoped rules of its key.
+// Maps, slices and structs are walked through their JSON form, so the rules
+// match their JSON field names.
+func (h *RedactingHandler) any(value any, scoped map[string]string) any {
+	switch v := value.(type) {
+	case nil:
+		return nil
+
+	case error:
+		return h.text(v.Error())
+
+	case fmt.Stringer:
+		return h.text(v.String())
+	}
+
+	switch reflect.Indirect(reflect.ValueOf(value)).Kind() {
+	case reflect.Map, reflect.Slice, reflect.Array, reflect.Struct:
+	default:
+		return value
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return constants.Redacted
+	}
+
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return constants.Redacted
+	}
+
+	return h.walk(decoded, scoped)
+}
+
+// walk redacts decoded JSON, with the scoped rules of the key holding it.
+func (h *RedactingHandler) walk(value any, scoped map[string]string) any {
+	switch v := value.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+
+		for key, field := range v {
+			action, ok := h.action(key, scoped)
+
+			switch {
+			case !ok:
+				out[key] = h.walk(field, h.scoped[strings.ToLower(key)])
+
+			case action == constants.RedactDrop:
+				// Left out
+
+			case action == constants.RedactMask:
+				out[key] = constants.Redacted
+
+			default:
+				if s, isString := field.(string); isString {
+					out[key] = h.apply(action, s)
+				} else {
+					out[key] = h.walk(field, h.scoped[strings.ToLower(key)])
+				}
+			}
+		}
+
+		return out
+
+	case []any:
+		out := make([]any, len(v))
+		for i := range v {
+			out[i] = h.walk(v[i], scoped)
+		}
+
+		return out
+
+	case string:
+		return h.text(v)
+
+	default:
+		return value
+	}
+}
+
+// apply applies an action other than drop to a value.
+func (h *RedactingHandler) apply(action, s string) string {
+	if action == constants.RedactTruncate {
+		return redact.Truncate(h.text(s), constants.LogTruncateLength)
+	}
+
+	return redact.Apply(action, s)
+}
+
+// text masks the emails in free text and caps its length.
+func (h *RedactingHandler) text(s string) string {
+	return redact.Truncate(redact.EmailsIn(s), h.maxValue)
+}
@@ -0,0 +1,121 @@
+package log_test
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	log "github.com/tazapay/tazapay-mcp-server/pkg/logs"
+	"github.com/tazapay/tazapay-mcp-server/pkg/redact"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+type customerDetails struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type payload struct {
+	Amount   int64           `json:"amount"`
+	Customer customerDetails `json:"customer_details"`
+}
+
+func TestRedactingHandler(t *testing.T) {
+	var buf bytes.Buffer
+
+	rules, err := log.ParseRules([]string{"reference_id:hash"})
+	if err != nil {
+		t.Fatalf("failed to parse rules: %v", err)
+	}
+
+	logger := slog.New(log.NewRedactingHandler(slog.NewJSONHandler(&buf, nil), append(log.DefaultRules(), rules...), 0))
+
+	logger.With(slog.String("Authorization", "Basic c2VjcmV0")).Info("payment link for ada@example.com",
+		slog.Any("args", map[string]any{
+			constants.CustomerNameField:  "Ada Lovelace",
+			constants.CustomerEmailField: "ada@example.com",
+			constants.PaymentAmountField: 10.5,
+			"reference_id":               "order-42",
+		}),
+		slog.Any("payload", payload{Amount: 1050, Customer: customerDetails{Name: "Ada Lovelace", Email: "ada@example.com"}}),
+		slog.String("body", strings.Repeat("x", 1000)),
+		slog.Any("error", errors.New("customer ada@example.com already exists")),
+	)
+
+	out := buf.String()
+
+	for _, leaked := range []string{"Ada Lovelace", "ada@example.com", "Basic c2VjcmV0", "order-42", strings.Repeat("x", 300)} {
+		if strings.Contains(out, leaked) {
+			t.Errorf("expected %q to be redacted, got: %s", leaked, out)
+		}
+	}
+
+	for _, kept := range []string{"a***@example.com", "sha256:", `"payment_amount":10.5`, `"amount":1050`, "truncated"} {
+		if !strings.Contains(out, kept) {
+			t.Errorf("expected %q in the log, got: %s", kept, out)
+		}
+	}
+}
+
+func TestRedactingHandlerAPIPayloads(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := slog.New(log.NewRedactingHandler(slog.NewJSONHandler(&buf, nil), log.DefaultRules(), 0))
+
+	address := &types.AddressDetails{
+		Name:    "Ada Lovelace",
+		Address: types.Address{Line1: "12 St James's Square", City: "London", Country: "GB", PostalCode: "SW1Y 4JH"},
+	}
+
+	logger.Info("constructed payment link payload", slog.Any("payload", types.PaymentLinkRequest{
+		CustomerDetails: map[string]string{"name": "Ada Lovelace", "email": "ada@example.com", "country": "GB"},
+		InvoiceCurrency: "GBP",
+		Amount:          1050,
+		Items:           []types.CheckoutItem{{Name: "Analytical Engine", Quantity: 1, Amount: 1050}},
+		ShippingDetails: address,
+		BillingDetails:  address,
+	}))
+
+	// A customer's name is personal data, a line item's is not
+	logger.Info("constructed customer payload", slog.Any("customer", &types.CustomerRequest{
+		Name:    "Ada Lovelace",
+		Email:   "ada@example.com",
+		Country: "GB",
+		Phone:   &types.Phone{CallingCode: "44", Number: "7700900123"},
+	}))
+
+	// Free text and metadata, as in the arguments logged by the tools
+	logger.Info("payment link args", slog.Any("args", map[string]any{
+		constants.TransactionDescField: "Invoice for Ada Lovelace",
+		constants.CustomerQueryField:   "Ada Lovelace",
+		"description":                  "Ada Lovelace's order",
+		constants.MetadataField:        map[string]any{"contact": "Ada Lovelace"},
+	}))
+
+	out := buf.String()
+
+	for _, leaked := range []string{"Ada Lovelace", "ada@example.com", "St James", "SW1Y", "7700900123"} {
+		if strings.Contains(out, leaked) {
+			t.Errorf("expected %q to be redacted, got: %s", leaked, out)
+		}
+	}
+
+	for _, kept := range []string{
+		`"shipping_details":"[redacted]"`, `"phone":"[redacted]"`, `"amount":1050`,
+		`"metadata":"[redacted]"`, `"transaction_description":"sha256:`, `"name":"Analytical Engine"`,
+		`"name":"` + redact.Hash("Ada Lovelace") + `"`,
+	} {
+		if !strings.Contains(out, kept) {
+			t.Errorf("expected %s in the log, got: %s", kept, out)
+		}
+	}
+}
+
+func TestParseRulesRejectsUnknownActions(t *testing.T) {
+	if _, err := log.ParseRules([]string{"email:encrypt"}); !errors.Is(err, constants.ErrInvalidRedactRule) {
+		t.Errorf("expected an invalid rule error, got: %v", err)
+	}
+}
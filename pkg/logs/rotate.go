@@ -0,0 +1,214 @@
+package log
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+)
+
+// rotatingFile is a log file that is renamed with a timestamp and replaced by
+// a new file once it exceeds a size or an age. Old rotated files are deleted
+// beyond a count or an age.
+type rotatingFile struct {
+	mu         sync.Mutex
+	path       string
+	maxSize    int64         // Zero disables rotation by size
+	maxAge     time.Duration // Zero disables rotation by age
+	maxBackups int           // Zero keeps every rotated file
+	retention  time.Duration // Zero keeps rotated files forever
+	now        func() time.Time
+
+	file    *os.File
+	size    int64
+	started time.Time
+}
+
+// openRotatingFile opens path for appending with the rotation settings of cfg.
+func openRotatingFile(path string, cfg Config) (*rotatingFile, error) {
+	f := &rotatingFile{
+		path:       path,
+		maxSize:    int64(cfg.MaxSizeMB) << 20,
+		maxAge:     cfg.MaxAge,
+		maxBackups: cfg.MaxBackups,
+		retention:  cfg.Retention,
+		now:        time.Now,
+	}
+
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// open opens the current file. A file left from an earlier run is aged from
+// its last write, since its creation time is not portably known.
+func (f *rotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, constants.OpenFileMode)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	f.file, f.size, f.started = file, info.Size(), f.now()
+	if f.size > 0 {
+		f.started = info.ModTime()
+	}
+
+	return nil
+}
+
+// Write implements io.Writer, rotating the file first when it is due.
+func (f *rotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.due(len(p)) {
+		if err := f.rotate(); err != nil {
+			// Keep logging to the current file rather than losing the record
+			fmt.Fprintf(os.Stderr, "Warning: failed to rotate log file: %v\n", err)
+		}
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+
+	return n, err
+}
+
+// due reports whether writing n more bytes should go to a new file.
+func (f *rotatingFile) due(n int) bool {
+	if f.size == 0 {
+		return false
+	}
+
+	return (f.maxSize > 0 && f.size+int64(n) > f.maxSize) || (f.maxAge > 0 && f.now().Sub(f.started) >= f.maxAge)
+}
+
+// rotate renames the current file to a backup, opens a new one and prunes old backups.
+func (f *rotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(f.path, f.backupName(f.now())); err != nil {
+		// Reopen the current file so logging can go on
+		if openErr := f.open(); openErr != nil {
+			return openErr
+		}
+
+		return err
+	}
+
+	if err := f.open(); err != nil {
+		return err
+	}
+
+	f.prune()
+
+	return nil
+}
+
+// backupName returns the name a file rotated at t is renamed to. Renaming
+// onto an existing backup would replace it, so a file rotated in the same
+// millisecond as an earlier one gets a sequence suffix, e.g. -20250131T090000.000-1.
+func (f *rotatingFile) backupName(t time.Time) string {
+	ext := filepath.Ext(f.path)
+	name := strings.TrimSuffix(f.path, ext) + "-" + t.UTC().Format(constants.LogBackupTimeFormat)
+
+	for seq := 0; ; seq++ {
+		candidate := name + ext
+		if seq > 0 {
+			candidate = name + "-" + strconv.Itoa(seq) + ext
+		}
+
+		if _, err := os.Lstat(candidate); errors.Is(err, fs.ErrNotExist) {
+			return candidate
+		}
+	}
+}
+
+// parseBackupStamp parses the time and sequence number that backupName put
+// in a backup name, without the prefix and extension.
+func parseBackupStamp(stamp string) (time.Time, int, error) {
+	seq := 0
+
+	if rest, suffix, ok := strings.Cut(stamp, "-"); ok {
+		n, err := strconv.Atoi(suffix)
+		if err != nil || n < 1 {
+			return time.Time{}, 0, fmt.Errorf("invalid backup sequence %q", suffix)
+		}
+
+		stamp, seq = rest, n
+	}
+
+	at, err := time.Parse(constants.LogBackupTimeFormat, stamp)
+
+	return at, seq, err
+}
+
+// prune deletes the backups beyond maxBackups and those older than retention.
+func (f *rotatingFile) prune() {
+	dir := filepath.Dir(f.path)
+	ext := filepath.Ext(f.path)
+	prefix := strings.TrimSuffix(filepath.Base(f.path), ext) + "-"
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+
+	type backup struct {
+		path string
+		at   time.Time
+		seq  int
+	}
+
+	var backups []backup
+
+	for _, e := range entries {
+		stamp, ok := strings.CutPrefix(e.Name(), prefix)
+		if !ok || !strings.HasSuffix(stamp, ext) {
+			continue
+		}
+
+		at, seq, err := parseBackupStamp(strings.TrimSuffix(stamp, ext))
+		if err == nil {
+			backups = append(backups, backup{filepath.Join(dir, e.Name()), at, seq})
+		}
+	}
+
+	// Newest first
+	slices.SortFunc(backups, func(a, b backup) int {
+		return cmp.Or(b.at.Compare(a.at), cmp.Compare(b.seq, a.seq))
+	})
+
+	for i, b := range backups {
+		if (f.maxBackups > 0 && i >= f.maxBackups) || (f.retention > 0 && f.now().Sub(b.at) > f.retention) {
+			_ = os.Remove(b.path)
+		}
+	}
+}
+
+// Close closes the current file.
+func (f *rotatingFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.file.Close()
+}
@@ -0,0 +1,189 @@
+// Package money represents amounts as integer minor units of a currency,
+// using the ISO 4217 exponent of each currency.
+package money
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+)
+
+const ceilTolerance = 1e-6
+
+// maxFloatMinor bounds the computed amounts, in minor units, that Round and
+// Ceil convert: beyond it a float64 no longer holds every integer.
+const maxFloatMinor = 1 << 53
+
+// Money is an amount in minor units of a currency, e.g. 1050 USD is USD 10.50.
+type Money struct {
+	Minor    int64
+	Currency string
+}
+
+// Exponent returns the number of minor-unit digits of currency.
+// Unknown currencies are assumed to have two.
+func Exponent(currency string) int {
+	if exp, ok := exponents[strings.ToUpper(currency)]; ok {
+		return exp
+	}
+
+	return constants.DefaultCurrencyExponent
+}
+
+// IsKnown reports whether currency is an ISO 4217 code.
+func IsKnown(currency string) bool {
+	_, ok := exponents[strings.ToUpper(currency)]
+	return ok
+}
+
+// FromMinor returns the Money for an amount in minor units.
+func FromMinor(minor int64, currency string) Money {
+	return Money{Minor: minor, Currency: strings.ToUpper(currency)}
+}
+
+// Parse parses a decimal string in major units, e.g. "10.50" or "1000".
+// It fails when the amount has more decimal places than the currency allows,
+// instead of silently rounding.
+func Parse(amount, currency string) (Money, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	s := strings.TrimSpace(amount)
+
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" && frac == "" || !isDigits(whole) || !isDigits(frac) {
+		return Money{}, fmt.Errorf("%w: %q", constants.ErrInvalidAmount, amount)
+	}
+
+	exp := Exponent(currency)
+
+	// Trailing zeros never lose precision, so "10.500" is a valid USD amount
+	frac = strings.TrimRight(frac, "0")
+	if len(frac) > exp {
+		return Money{}, fmt.Errorf("%w: %s allows at most %d decimal places, got %q",
+			constants.ErrAmountPrecision, currency, exp, amount)
+	}
+
+	digits := strings.TrimLeft(whole+frac+strings.Repeat("0", exp-len(frac)), "0")
+	if digits == "" {
+		digits = "0"
+	}
+
+	minor, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: %q", constants.ErrInvalidAmount, amount)
+	}
+
+	if neg {
+		minor = -minor
+	}
+
+	return Money{Minor: minor, Currency: currency}, nil
+}
+
+// FromFloat converts a major-unit amount given as a JSON number. The number's
+// shortest decimal form is parsed, so 10.1 becomes exactly 1010 cents.
+func FromFloat(amount float64, currency string) (Money, error) {
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return Money{}, fmt.Errorf("%w: %v", constants.ErrInvalidAmount, amount)
+	}
+
+	return Parse(strconv.FormatFloat(amount, 'f', -1, 64), currency)
+}
+
+// Round converts a computed major-unit amount, such as an FX conversion,
+// rounding half away from zero to the currency's minor unit. It fails when
+// the amount is too large to be converted exactly.
+func Round(amount float64, currency string) (Money, error) {
+	v, err := toMinor(amount, currency)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{Minor: int64(math.Round(v)), Currency: strings.ToUpper(currency)}, nil
+}
+
+// Ceil converts a computed major-unit amount, rounding up to the currency's
+// minor unit, e.g. to make sure a conversion covers a required amount.
+// Float noise below a millionth of a minor unit is ignored. It fails when
+// the amount is too large to be converted exactly.
+func Ceil(amount float64, currency string) (Money, error) {
+	v, err := toMinor(amount, currency)
+	if err != nil {
+		return Money{}, err
+	}
+
+	minor := math.Round(v)
+	if math.Abs(v-minor) > ceilTolerance {
+		minor = math.Ceil(v)
+	}
+
+	return Money{Minor: int64(minor), Currency: strings.ToUpper(currency)}, nil
+}
+
+// toMinor scales a major-unit amount to minor units, checking that the
+// result converts to int64 exactly.
+func toMinor(amount float64, currency string) (float64, error) {
+	v := amount * math.Pow10(Exponent(currency))
+
+	// Also false for NaN
+	if !(math.Abs(v) < maxFloatMinor) {
+		return 0, fmt.Errorf("%w: %v %s is out of range", constants.ErrInvalidAmount, amount, strings.ToUpper(currency))
+	}
+
+	return v, nil
+}
+
+// String formats the amount in major units with the currency's decimal places, e.g. "10.50".
+func (m Money) String() string {
+	exp := Exponent(m.Currency)
+
+	minor := m.Minor
+	sign := ""
+
+	if minor < 0 {
+		sign = "-"
+		minor = -minor
+	}
+
+	s := strconv.FormatInt(minor, 10)
+	if exp == 0 {
+		return sign + s
+	}
+
+	if len(s) <= exp {
+		s = strings.Repeat("0", exp-len(s)+1) + s
+	}
+
+	return sign + s[:len(s)-exp] + "." + s[len(s)-exp:]
+}
+
+// Display formats the amount with its currency code, e.g. "USD 10.50".
+func (m Money) Display() string {
+	return m.Currency + " " + m.String()
+}
+
+// Float64 returns the amount in major units for rate calculations.
+// Never use it to build a payload; use Minor instead.
+func (m Money) Float64() float64 {
+	return float64(m.Minor) / math.Pow10(Exponent(m.Currency))
+}
+
+// IsPositive reports whether the amount is greater than zero.
+func (m Money) IsPositive() bool {
+	return m.Minor > 0
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
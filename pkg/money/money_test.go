@@ -0,0 +1,90 @@
+package money_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/money"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		amount   string
+		currency string
+		minor    int64
+		display  string
+	}{
+		{"10.50", "usd", 1050, "USD 10.50"},
+		{"10.5", "USD", 1050, "USD 10.50"},
+		{"0.07", "USD", 7, "USD 0.07"},
+		{"10.500", "USD", 1050, "USD 10.50"},
+		{"1500", "JPY", 1500, "JPY 1500"},
+		{"25000", "IDR", 25000, "IDR 25000"},
+		{"1.234", "KWD", 1234, "KWD 1.234"},
+		{".5", "BHD", 500, "BHD 0.500"},
+		{"-3.2", "EUR", -320, "EUR -3.20"},
+	}
+
+	for _, tt := range tests {
+		m, err := money.Parse(tt.amount, tt.currency)
+		if err != nil {
+			t.Errorf("Parse(%q, %q): unexpected error: %v", tt.amount, tt.currency, err)
+			continue
+		}
+
+		if m.Minor != tt.minor || m.Display() != tt.display {
+			t.Errorf("Parse(%q, %q) = %d %q, want %d %q", tt.amount, tt.currency, m.Minor, m.Display(), tt.minor, tt.display)
+		}
+	}
+}
+
+func TestParseRejectsInvalidAmounts(t *testing.T) {
+	for _, amount := range []string{"", ".", "abc", "1.2.3", "1e3", "10,50", "99999999999999999999"} {
+		if _, err := money.Parse(amount, "USD"); !errors.Is(err, constants.ErrInvalidAmount) {
+			t.Errorf("Parse(%q): expected ErrInvalidAmount, got: %v", amount, err)
+		}
+	}
+
+	for _, tt := range [][2]string{{"10.5", "JPY"}, {"1.001", "USD"}, {"1.2345", "KWD"}} {
+		if _, err := money.Parse(tt[0], tt[1]); !errors.Is(err, constants.ErrAmountPrecision) {
+			t.Errorf("Parse(%q, %q): expected ErrAmountPrecision, got: %v", tt[0], tt[1], err)
+		}
+	}
+}
+
+func TestFromFloatIsExact(t *testing.T) {
+	// 0.29 * 100 is 28.999999999999996 in float math
+	m, err := money.FromFloat(0.29, "USD")
+	if err != nil || m.Minor != 29 {
+		t.Errorf("FromFloat(0.29) = %d, %v; want 29", m.Minor, err)
+	}
+
+	m, err = money.FromFloat(1234567.89, "SGD")
+	if err != nil || m.Minor != 123456789 {
+		t.Errorf("FromFloat(1234567.89) = %d, %v; want 123456789", m.Minor, err)
+	}
+}
+
+func TestRound(t *testing.T) {
+	if got, err := money.Round(8312.456, "INR"); err != nil || got.String() != "8312.46" {
+		t.Errorf("Round INR = %s, %v, want 8312.46", got, err)
+	}
+
+	if got, err := money.Round(1234.5, "JPY"); err != nil || got.String() != "1235" {
+		t.Errorf("Round JPY = %s, %v, want 1235", got, err)
+	}
+}
+
+func TestRoundRejectsOutOfRangeAmounts(t *testing.T) {
+	for _, amount := range []float64{1e17, -1e17, math.Inf(1), math.NaN()} {
+		if m, err := money.Round(amount, "USD"); !errors.Is(err, constants.ErrInvalidAmount) {
+			t.Errorf("Round(%v) = %d, %v, want ErrInvalidAmount", amount, m.Minor, err)
+		}
+
+		if m, err := money.Ceil(amount, "USD"); !errors.Is(err, constants.ErrInvalidAmount) {
+			t.Errorf("Ceil(%v) = %d, %v, want ErrInvalidAmount", amount, m.Minor, err)
+		}
+	}
+}
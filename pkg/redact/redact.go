@@ -0,0 +1,127 @@
+// Package redact hides personal data and secrets in values that are written
+// to logs and the audit log, keeping enough to recognize a value.
+package redact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+)
+
+// Fields maps the fields that hold personal data, as tool arguments and as
+// API payload fields, to the constants.Redact* action that hides them. The
+// audit log and the log handler both apply it, at any depth.
+var Fields = map[string]string{
+	// Names are hashed, so that log lines about the same customer can still
+	// be correlated
+	constants.CustomerNameField: constants.RedactHash,
+
+	constants.CustomerEmailField:    constants.RedactMaskEmail,
+	constants.BeneficiaryEmailField: constants.RedactMaskEmail,
+	constants.CustomerPhoneField:    constants.RedactLastFour,
+	constants.AccountNumberField:    constants.RedactLastFour,
+	constants.DepositKeyField:       constants.RedactLastFour,
+
+	// Free text that often names the customer or holds an email address; the
+	// hash still shows whether two calls used the same text
+	constants.TransactionDescField: constants.RedactHash,
+	constants.CustomerQueryField:   constants.RedactHash,
+	"description":                  constants.RedactHash,
+
+	// Whole values that are personal data, or may be, like metadata, whose
+	// values are up to the merchant
+	constants.ShippingAddressField: constants.RedactMask,
+	constants.BillingAddressField:  constants.RedactMask,
+	constants.MetadataField:        constants.RedactMask,
+	"shipping_details":             constants.RedactMask,
+	"billing_details":              constants.RedactMask,
+	"address":                      constants.RedactMask,
+	"phone":                        constants.RedactMask,
+}
+
+// Scoped maps the keys of objects that describe a customer or a beneficiary
+// to the rules for their fields whose names are too generic for Fields: the
+// name of a line item is not personal data, the name of a customer is.
+var Scoped = map[string]map[string]string{
+	"customer":                  {"name": constants.RedactHash},
+	"customer_details":          {"name": constants.RedactHash},
+	constants.ObjectBeneficiary: {constants.BeneficiaryNameField: constants.RedactHash},
+}
+
+// ToolScopes maps the tools whose arguments describe a customer or a
+// beneficiary to the key of their rules in Scoped.
+var ToolScopes = map[string]string{
+	constants.CreateBeneficiaryToolName: constants.ObjectBeneficiary,
+}
+
+// Apply applies a mask_email, hash or last4 action to a value, and hides it
+// for any other action.
+func Apply(action, s string) string {
+	switch action {
+	case constants.RedactMaskEmail:
+		return Email(s)
+
+	case constants.RedactHash:
+		return Hash(s)
+
+	case constants.RedactLastFour:
+		return LastFour(s)
+
+	default:
+		return constants.Redacted
+	}
+}
+
+// emailPattern finds email addresses in free text.
+var emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+// Email keeps the first letter and the domain of an email address.
+func Email(s string) string {
+	local, domain, ok := strings.Cut(s, "@")
+	if !ok || local == "" {
+		return constants.Redacted
+	}
+
+	_, size := utf8.DecodeRuneInString(local)
+
+	return local[:size] + "***@" + domain
+}
+
+// EmailsIn masks every email address in free text, such as an error message.
+func EmailsIn(s string) string {
+	return emailPattern.ReplaceAllStringFunc(s, Email)
+}
+
+// LastFour keeps the last four characters of a value long enough to hide the rest.
+func LastFour(s string) string {
+	if len(s) <= 4 {
+		return constants.Redacted
+	}
+
+	return strings.Repeat("*", len(s)-4) + s[len(s)-4:]
+}
+
+// Hash replaces a value with a short hash of it, so that log lines about the
+// same customer can still be correlated.
+func Hash(s string) string {
+	sum := sha256.Sum256([]byte(s))
+
+	return "sha256:" + hex.EncodeToString(sum[:6])
+}
+
+// Truncate cuts s to at most n bytes, on a rune boundary, marking the cut.
+func Truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+
+	return s[:n] + "...(truncated)"
+}
@@ -0,0 +1,70 @@
+package redact_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/redact"
+)
+
+func TestEmail(t *testing.T) {
+	tests := map[string]string{
+		"ada@example.com":  "a***@example.com",
+		"élise@example.fr": "é***@example.fr",
+		"@example.com":     constants.Redacted,
+		"not an email":     constants.Redacted,
+	}
+
+	for in, want := range tests {
+		if got := redact.Email(in); got != want {
+			t.Errorf("Email(%q): expected %q, got: %q", in, want, got)
+		}
+	}
+}
+
+func TestEmailsIn(t *testing.T) {
+	got := redact.EmailsIn("customer ada@example.com already exists, ask bob.smith+x@mail.example.org")
+	want := "customer a***@example.com already exists, ask b***@mail.example.org"
+
+	if got != want {
+		t.Errorf("expected %q, got: %q", want, got)
+	}
+}
+
+func TestLastFour(t *testing.T) {
+	tests := map[string]string{
+		"12345678": "****5678",
+		"1234":     constants.Redacted,
+		"":         constants.Redacted,
+	}
+
+	for in, want := range tests {
+		if got := redact.LastFour(in); got != want {
+			t.Errorf("LastFour(%q): expected %q, got: %q", in, want, got)
+		}
+	}
+}
+
+func TestHash(t *testing.T) {
+	got := redact.Hash("Ada Lovelace")
+
+	if !strings.HasPrefix(got, "sha256:") || len(got) != len("sha256:")+12 || strings.Contains(got, "Ada") {
+		t.Errorf("expected a short sha256 hash, got: %q", got)
+	}
+
+	if redact.Hash("Ada Lovelace") != got || redact.Hash("Ada") == got {
+		t.Error("expected equal values to hash equally and different values differently")
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	if got := redact.Truncate("short", 10); got != "short" {
+		t.Errorf("expected a short value to be kept, got: %q", got)
+	}
+
+	// "é" is two bytes, so a cut at 2 must not split it
+	if got := redact.Truncate("aébc", 2); got != "a...(truncated)" {
+		t.Errorf("expected a cut on a rune boundary, got: %q", got)
+	}
+}
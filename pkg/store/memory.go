@@ -0,0 +1,147 @@
+package store
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// Memory is a Store that keeps the latest records in memory.
+type Memory struct {
+	mu      sync.Mutex
+	limit   int
+	calls   []types.ToolCall     // Oldest first
+	objects []types.StoredObject // Oldest first by creation
+	events  []types.Event        // Oldest first
+	seen    map[string]struct{}  // IDs of the events kept
+}
+
+// NewMemory returns a store that keeps the latest limit calls, objects and events.
+func NewMemory(limit int) *Memory {
+	return &Memory{limit: max(limit, 1), seen: map[string]struct{}{}}
+}
+
+// AppendEvent implements EventStore.
+func (m *Memory) AppendEvent(_ context.Context, e types.Event) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.seen[e.ID]; ok {
+		return false, nil
+	}
+
+	if len(m.events) == m.limit {
+		delete(m.seen, m.events[0].ID)
+		m.events = m.events[1:]
+	}
+
+	m.events = append(m.events, e)
+	m.seen[e.ID] = struct{}{}
+
+	return true, nil
+}
+
+// Events implements EventStore.
+func (m *Memory) Events(_ context.Context, q EventQuery) ([]types.Event, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return newest(m.events, q.Limit, func(e types.Event) bool {
+		return q.contains(e.ReceivedAt) && q.matchesType(e.Type) && (q.ObjectID == "" || e.ObjectID == q.ObjectID) &&
+			(q.Environment == "" || e.Environment == q.Environment)
+	}), nil
+}
+
+// RecordCall implements Store.
+func (m *Memory) RecordCall(_ context.Context, c types.ToolCall) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.calls) == m.limit {
+		m.calls = m.calls[1:]
+	}
+
+	m.calls = append(m.calls, c)
+
+	return nil
+}
+
+// Calls implements Store.
+func (m *Memory) Calls(_ context.Context, q CallQuery) ([]types.ToolCall, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return newest(m.calls, q.Limit, func(c types.ToolCall) bool {
+		return q.contains(c.StartedAt) && (q.Tool == "" || c.Tool == q.Tool) && allows(q.Tools, c.Tool) &&
+			(!q.ByPrincipal || c.Principal == q.Principal) && (q.Environment == "" || c.Environment == q.Environment)
+	}), nil
+}
+
+// SaveObject implements Store.
+func (m *Memory) SaveObject(_ context.Context, o types.StoredObject) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i := range m.objects {
+		if m.objects[i].ID == o.ID {
+			o.CreatedAt, o.Principal = m.objects[i].CreatedAt, m.objects[i].Principal
+			m.objects[i] = o
+
+			return nil
+		}
+	}
+
+	if len(m.objects) == m.limit {
+		m.objects = m.objects[1:]
+	}
+
+	m.objects = append(m.objects, o)
+
+	return nil
+}
+
+// Objects implements Store.
+func (m *Memory) Objects(_ context.Context, q ObjectQuery) ([]types.StoredObject, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return newest(m.objects, q.Limit, func(o types.StoredObject) bool {
+		return q.contains(o.CreatedAt) && (q.Kind == "" || o.Kind == q.Kind) && allows(q.Tools, o.Tool) &&
+			(!q.ByPrincipal || o.Principal == q.Principal) && (q.Environment == "" || o.Environment == q.Environment)
+	}), nil
+}
+
+// Close implements Store.
+func (*Memory) Close() error {
+	return nil
+}
+
+// matchesType reports whether an event type passes the query.
+func (q EventQuery) matchesType(t string) bool {
+	return q.Type == "" || t == q.Type || (strings.HasSuffix(q.Type, ".") && strings.HasPrefix(t, q.Type))
+}
+
+// allows reports whether tools, a filter that is nil when it is unset, holds tool.
+func allows(tools []string, tool string) bool {
+	return tools == nil || slices.Contains(tools, tool)
+}
+
+// newest returns up to limit records that match, newest first, from records kept oldest first.
+func newest[T any](records []T, limit int, match func(T) bool) []T {
+	out := []T{}
+
+	for i := len(records) - 1; i >= 0; i-- {
+		if limit > 0 && len(out) == limit {
+			break
+		}
+
+		if match(records[i]) {
+			out = append(out, records[i])
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,362 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite" // Registers the "sqlite" database/sql driver
+
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// schema creates the tables on first use. Times are stored as Unix
+// nanoseconds so that they sort and compare as integers.
+const schema = `
+CREATE TABLE IF NOT EXISTS tool_calls (
+	tool        TEXT    NOT NULL,
+	principal   TEXT    NOT NULL,
+	environment TEXT    NOT NULL,
+	arguments   TEXT    NOT NULL,
+	outcome     TEXT    NOT NULL,
+	error_code  TEXT    NOT NULL,
+	object_id   TEXT    NOT NULL,
+	started_at  INTEGER NOT NULL,
+	duration_ms INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS tool_calls_started_at ON tool_calls (started_at);
+
+CREATE TABLE IF NOT EXISTS objects (
+	id          TEXT PRIMARY KEY,
+	kind        TEXT    NOT NULL,
+	tool        TEXT    NOT NULL,
+	principal   TEXT    NOT NULL,
+	environment TEXT    NOT NULL,
+	data        TEXT    NOT NULL,
+	created_at  INTEGER NOT NULL,
+	updated_at  INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS objects_created_at ON objects (created_at);
+
+CREATE TABLE IF NOT EXISTS events (
+	id          TEXT PRIMARY KEY,
+	type        TEXT    NOT NULL,
+	object_id   TEXT    NOT NULL,
+	environment TEXT    NOT NULL,
+	created_at  TEXT    NOT NULL,
+	received_at INTEGER NOT NULL,
+	data        TEXT    NOT NULL
+);
+CREATE INDEX IF NOT EXISTS events_received_at ON events (received_at);
+`
+
+// SQLite is a Store backed by a SQLite database file.
+type SQLite struct {
+	db *sql.DB
+}
+
+// OpenSQLite opens the database at path, creating it and its tables if needed.
+func OpenSQLite(ctx context.Context, path string) (*SQLite, error) {
+	// Escaped, so a path with ?, # or % names the file rather than the
+	// options. SQLite takes a Windows drive after a slash, as in file:/C:/db
+	uriPath := filepath.ToSlash(path)
+	if filepath.VolumeName(path) != "" {
+		uriPath = "/" + uriPath
+	}
+
+	dsn := url.URL{
+		Scheme: "file", Path: uriPath, OmitHost: true,
+		RawQuery: "_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)",
+	}
+
+	db, err := sql.Open("sqlite", dsn.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open store %s: %w", path, err)
+	}
+
+	// SQLite allows one writer at a time; a single connection avoids busy errors
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.ExecContext(ctx, schema); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to create store tables in %s: %w", path, err)
+	}
+
+	return &SQLite{db: db}, nil
+}
+
+// AppendEvent implements EventStore.
+func (s *SQLite) AppendEvent(ctx context.Context, e types.Event) (bool, error) {
+	res, err := s.db.ExecContext(ctx,
+		`INSERT OR IGNORE INTO events (id, type, object_id, environment, created_at, received_at, data)`+
+			` VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		e.ID, e.Type, e.ObjectID, e.Environment, e.CreatedAt, e.ReceivedAt.UnixNano(), string(e.Data))
+	if err != nil {
+		return false, fmt.Errorf("failed to store event %s: %w", e.ID, err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to store event %s: %w", e.ID, err)
+	}
+
+	return n == 1, nil
+}
+
+// Events implements EventStore.
+func (s *SQLite) Events(ctx context.Context, q EventQuery) ([]types.Event, error) {
+	w := where{}
+	w.window("received_at", q.Window)
+
+	switch {
+	case strings.HasSuffix(q.Type, "."):
+		w.add("substr(type, 1, ?) = ?", len(q.Type), q.Type)
+
+	case q.Type != "":
+		w.add("type = ?", q.Type)
+	}
+
+	if q.ObjectID != "" {
+		w.add("object_id = ?", q.ObjectID)
+	}
+
+	if q.Environment != "" {
+		w.add("environment = ?", q.Environment)
+	}
+
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT id, type, object_id, environment, created_at, received_at, data FROM events`+
+			w.sql("received_at", q.Limit), w.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	return scan(rows, func(e *types.Event) error {
+		var (
+			received int64
+			data     string
+		)
+
+		if err := rows.Scan(&e.ID, &e.Type, &e.ObjectID, &e.Environment, &e.CreatedAt, &received, &data); err != nil {
+			return err
+		}
+
+		e.ReceivedAt = time.Unix(0, received).UTC()
+		e.Data = json.RawMessage(data)
+
+		return nil
+	})
+}
+
+// RecordCall implements Store.
+func (s *SQLite) RecordCall(ctx context.Context, c types.ToolCall) error {
+	args, err := json.Marshal(c.Arguments)
+	if err != nil {
+		return fmt.Errorf("failed to encode arguments of %s: %w", c.Tool, err)
+	}
+
+	_, err = s.db.ExecContext(ctx,
+		`INSERT INTO tool_calls (tool, principal, environment, arguments, outcome, error_code, object_id, started_at,`+
+			` duration_ms) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		c.Tool, c.Principal, c.Environment, string(args), c.Outcome, c.ErrorCode, c.ObjectID, c.StartedAt.UnixNano(),
+		c.DurationMS)
+	if err != nil {
+		return fmt.Errorf("failed to store call of %s: %w", c.Tool, err)
+	}
+
+	return nil
+}
+
+// Calls implements Store.
+func (s *SQLite) Calls(ctx context.Context, q CallQuery) ([]types.ToolCall, error) {
+	w := where{}
+	w.window("started_at", q.Window)
+
+	if q.Tool != "" {
+		w.add("tool = ?", q.Tool)
+	}
+
+	w.in("tool", q.Tools)
+
+	if q.ByPrincipal {
+		w.add("principal = ?", q.Principal)
+	}
+
+	if q.Environment != "" {
+		w.add("environment = ?", q.Environment)
+	}
+
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT tool, principal, environment, arguments, outcome, error_code, object_id, started_at, duration_ms`+
+			` FROM tool_calls`+w.sql("started_at", q.Limit), w.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tool calls: %w", err)
+	}
+
+	return scan(rows, func(c *types.ToolCall) error {
+		var (
+			args    string
+			started int64
+		)
+
+		err := rows.Scan(&c.Tool, &c.Principal, &c.Environment, &args, &c.Outcome, &c.ErrorCode, &c.ObjectID,
+			&started, &c.DurationMS)
+		if err != nil {
+			return err
+		}
+
+		c.StartedAt = time.Unix(0, started).UTC()
+
+		return json.Unmarshal([]byte(args), &c.Arguments)
+	})
+}
+
+// SaveObject implements Store.
+func (s *SQLite) SaveObject(ctx context.Context, o types.StoredObject) error {
+	_, err := s.db.ExecContext(ctx,
+		`INSERT INTO objects (id, kind, tool, principal, environment, data, created_at, updated_at)`+
+			` VALUES (?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT (id) DO UPDATE SET kind = excluded.kind, tool = excluded.tool,`+
+			` environment = excluded.environment, data = excluded.data, updated_at = excluded.updated_at`,
+		o.ID, o.Kind, o.Tool, o.Principal, o.Environment, string(o.Data), o.CreatedAt.UnixNano(), o.UpdatedAt.UnixNano())
+	if err != nil {
+		return fmt.Errorf("failed to store %s %s: %w", o.Kind, o.ID, err)
+	}
+
+	return nil
+}
+
+// Objects implements Store.
+func (s *SQLite) Objects(ctx context.Context, q ObjectQuery) ([]types.StoredObject, error) {
+	w := where{}
+	w.window("created_at", q.Window)
+
+	if q.Kind != "" {
+		w.add("kind = ?", q.Kind)
+	}
+
+	w.in("tool", q.Tools)
+
+	if q.ByPrincipal {
+		w.add("principal = ?", q.Principal)
+	}
+
+	if q.Environment != "" {
+		w.add("environment = ?", q.Environment)
+	}
+
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT id, kind, tool, principal, environment, data, created_at, updated_at FROM objects`+
+			w.sql("created_at", q.Limit), w.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list objects: %w", err)
+	}
+
+	return scan(rows, func(o *types.StoredObject) error {
+		var (
+			data             string
+			created, updated int64
+		)
+
+		if err := rows.Scan(&o.ID, &o.Kind, &o.Tool, &o.Principal, &o.Environment, &data, &created, &updated); err != nil {
+			return err
+		}
+
+		o.Data = json.RawMessage(data)
+		o.CreatedAt = time.Unix(0, created).UTC()
+		o.UpdatedAt = time.Unix(0, updated).UTC()
+
+		return nil
+	})
+}
+
+// Close implements Store.
+func (s *SQLite) Close() error {
+	return s.db.Close()
+}
+
+// where builds the WHERE clause of a query.
+type where struct {
+	conds []string
+	args  []any
+}
+
+// add appends a condition and its arguments.
+func (w *where) add(cond string, args ...any) {
+	w.conds = append(w.conds, cond)
+	w.args = append(w.args, args...)
+}
+
+// in restricts column to values, unless values is nil.
+func (w *where) in(column string, values []string) {
+	if values == nil {
+		return
+	}
+
+	if len(values) == 0 {
+		w.add("FALSE")
+		return
+	}
+
+	args := make([]any, len(values))
+	for i, v := range values {
+		args[i] = v
+	}
+
+	w.add(column+" IN (?"+strings.Repeat(", ?", len(values)-1)+")", args...)
+}
+
+// window restricts column, a time in Unix nanoseconds, to the window.
+func (w *where) window(column string, win Window) {
+	if !win.Since.IsZero() {
+		w.add(column+" >= ?", win.Since.UnixNano())
+	}
+
+	if !win.Until.IsZero() {
+		w.add(column+" < ?", win.Until.UnixNano())
+	}
+}
+
+// sql returns the clause, ordered newest first by column and limited to limit rows.
+func (w *where) sql(column string, limit int) string {
+	var b strings.Builder
+
+	if len(w.conds) > 0 {
+		b.WriteString(" WHERE " + strings.Join(w.conds, " AND "))
+	}
+
+	// rowid breaks ties between records with the same time
+	b.WriteString(" ORDER BY " + column + " DESC, rowid DESC")
+
+	if limit > 0 {
+		fmt.Fprintf(&b, " LIMIT %d", limit)
+	}
+
+	return b.String()
+}
+
+// scan reads every row with read and closes rows.
+func scan[T any](rows *sql.Rows, read func(*T) error) ([]T, error) {
+	defer rows.Close()
+
+	out := []T{}
+
+	for rows.Next() {
+		var v T
+		if err := read(&v); err != nil {
+			return nil, fmt.Errorf("failed to read store row: %w", err)
+		}
+
+		out = append(out, v)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read store rows: %w", err)
+	}
+
+	return out, nil
+}
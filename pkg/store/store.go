@@ -0,0 +1,82 @@
+// Package store persists what the server learns: tool calls, the Tazapay
+// objects they create and received webhook events. It has an in-memory
+// backend, which forgets everything on restart, and a SQLite backend.
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// EventStore records webhook events. Tazapay retries deliveries, so events
+// are de-duplicated by ID.
+type EventStore interface {
+	// AppendEvent stores e and reports whether it was new.
+	AppendEvent(ctx context.Context, e types.Event) (bool, error)
+
+	// Events returns the events that match q, newest first.
+	Events(ctx context.Context, q EventQuery) ([]types.Event, error)
+}
+
+// Store records tool calls, created objects and webhook events.
+type Store interface {
+	EventStore
+
+	// RecordCall stores a tool call.
+	RecordCall(ctx context.Context, c types.ToolCall) error
+
+	// Calls returns the tool calls that match q, newest first.
+	Calls(ctx context.Context, q CallQuery) ([]types.ToolCall, error)
+
+	// SaveObject stores o, replacing an earlier version with the same ID but
+	// keeping its creation time and the principal that created it.
+	SaveObject(ctx context.Context, o types.StoredObject) error
+
+	// Objects returns the objects that match q, newest first.
+	Objects(ctx context.Context, q ObjectQuery) ([]types.StoredObject, error)
+
+	// Close releases the store.
+	Close() error
+}
+
+// Window restricts a query to records in [Since, Until). Zero bounds are open.
+type Window struct {
+	Since time.Time
+	Until time.Time
+	Limit int // Zero means no limit
+}
+
+// contains reports whether t lies in the window.
+func (w Window) contains(t time.Time) bool {
+	return (w.Since.IsZero() || !t.Before(w.Since)) && (w.Until.IsZero() || t.Before(w.Until))
+}
+
+// EventQuery selects events by when they were received. Zero fields match every event.
+type EventQuery struct {
+	Window
+	Type        string // An exact type, or a prefix ending in "." such as "payout."
+	ObjectID    string
+	Environment string
+}
+
+// CallQuery selects tool calls by when they started. Zero fields match every call.
+type CallQuery struct {
+	Window
+	Tool        string
+	Tools       []string // Only calls of these tools; nil means every tool
+	Principal   string
+	ByPrincipal bool // Only calls made by Principal, even when it is empty
+	Environment string
+}
+
+// ObjectQuery selects objects by when they were created. Zero fields match every object.
+type ObjectQuery struct {
+	Window
+	Kind        string
+	Tools       []string // Only objects created or changed by these tools; nil means every tool
+	Principal   string
+	ByPrincipal bool // Only objects created by Principal, even when it is empty
+	Environment string
+}
@@ -0,0 +1,252 @@
+package store_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/tazapay/tazapay-mcp-server/pkg/store"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// backends returns a fresh store of each kind.
+func backends(t *testing.T) map[string]store.Store {
+	t.Helper()
+
+	sqlite, err := store.OpenSQLite(context.Background(), filepath.Join(t.TempDir(), "store.db"))
+	if err != nil {
+		t.Fatalf("failed to open SQLite store: %v", err)
+	}
+
+	t.Cleanup(func() { _ = sqlite.Close() })
+
+	return map[string]store.Store{"memory": store.NewMemory(10), "sqlite": sqlite}
+}
+
+var base = time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC)
+
+func TestStoreCalls(t *testing.T) {
+	ctx := context.Background()
+
+	for name, s := range backends(t) {
+		t.Run(name, func(t *testing.T) {
+			for i, tool := range []string{"payment_link", "get_checkout", "payment_link"} {
+				err := s.RecordCall(ctx, types.ToolCall{
+					Tool:        tool,
+					Environment: "sandbox",
+					Arguments:   map[string]any{"amount": "10.00"},
+					Outcome:     "ok",
+					StartedAt:   base.Add(time.Duration(i) * time.Hour),
+				})
+				if err != nil {
+					t.Fatalf("failed to record call: %v", err)
+				}
+			}
+
+			calls, err := s.Calls(ctx, store.CallQuery{Tool: "payment_link"})
+			if err != nil {
+				t.Fatalf("failed to list calls: %v", err)
+			}
+
+			if len(calls) != 2 || !calls[0].StartedAt.Equal(base.Add(2*time.Hour)) || calls[0].Arguments["amount"] != "10.00" {
+				t.Errorf("expected the 2 payment link calls newest first, got: %+v", calls)
+			}
+
+			window := store.Window{Since: base.Add(time.Hour), Until: base.Add(2 * time.Hour)}
+
+			calls, err = s.Calls(ctx, store.CallQuery{Window: window})
+			if err != nil {
+				t.Fatalf("failed to list calls: %v", err)
+			}
+
+			if len(calls) != 1 || calls[0].Tool != "get_checkout" {
+				t.Errorf("expected the call within the window, got: %+v", calls)
+			}
+		})
+	}
+}
+
+func TestStoreObjectsUpsert(t *testing.T) {
+	ctx := context.Background()
+
+	for name, s := range backends(t) {
+		t.Run(name, func(t *testing.T) {
+			save := func(id, kind, data string, at time.Time) {
+				err := s.SaveObject(ctx, types.StoredObject{
+					ID: id, Kind: kind, Environment: "sandbox", Data: json.RawMessage(data), CreatedAt: at, UpdatedAt: at,
+				})
+				if err != nil {
+					t.Fatalf("failed to save object: %v", err)
+				}
+			}
+
+			save("cus_1", "customer", `{"name":"Ada"}`, base)
+			save("chk_1", "checkout", `{}`, base.Add(time.Hour))
+			save("cus_1", "customer", `{"name":"Ada Lovelace"}`, base.Add(2*time.Hour))
+
+			objects, err := s.Objects(ctx, store.ObjectQuery{Kind: "customer"})
+			if err != nil {
+				t.Fatalf("failed to list objects: %v", err)
+			}
+
+			if len(objects) != 1 || string(objects[0].Data) != `{"name":"Ada Lovelace"}` ||
+				!objects[0].CreatedAt.Equal(base) || !objects[0].UpdatedAt.Equal(base.Add(2*time.Hour)) {
+				t.Errorf("expected the updated customer with its original creation time, got: %+v", objects)
+			}
+
+			if objects, _ = s.Objects(ctx, store.ObjectQuery{Environment: "production"}); len(objects) != 0 {
+				t.Errorf("expected no production objects, got: %+v", objects)
+			}
+		})
+	}
+}
+
+func TestStoreFiltersByToolAndPrincipal(t *testing.T) {
+	ctx := context.Background()
+
+	for name, s := range backends(t) {
+		t.Run(name, func(t *testing.T) {
+			for i, c := range []types.ToolCall{
+				{Tool: "payment_link", Principal: "ada"},
+				{Tool: "create_payout", Principal: "ada"},
+				{Tool: "payment_link", Principal: "bob"},
+			} {
+				c.StartedAt = base.Add(time.Duration(i) * time.Minute)
+				if err := s.RecordCall(ctx, c); err != nil {
+					t.Fatalf("failed to record call: %v", err)
+				}
+
+				err := s.SaveObject(ctx, types.StoredObject{
+					ID: fmt.Sprintf("obj_%d", i), Tool: c.Tool, Principal: c.Principal, Data: json.RawMessage(`{}`),
+					CreatedAt: c.StartedAt, UpdatedAt: c.StartedAt,
+				})
+				if err != nil {
+					t.Fatalf("failed to save object: %v", err)
+				}
+			}
+
+			calls, _ := s.Calls(ctx, store.CallQuery{Tools: []string{"payment_link"}, Principal: "ada", ByPrincipal: true})
+			if len(calls) != 1 || calls[0].Tool != "payment_link" || calls[0].Principal != "ada" {
+				t.Errorf("expected only ada's payment link call, got: %+v", calls)
+			}
+
+			objects, _ := s.Objects(ctx, store.ObjectQuery{Tools: []string{"payment_link"}, Principal: "ada", ByPrincipal: true})
+			if len(objects) != 1 || objects[0].ID != "obj_0" || objects[0].Principal != "ada" {
+				t.Errorf("expected only ada's payment link object, got: %+v", objects)
+			}
+
+			if calls, _ = s.Calls(ctx, store.CallQuery{Tools: []string{}}); len(calls) != 0 {
+				t.Errorf("expected no calls for an empty tool list, got: %+v", calls)
+			}
+
+			// An empty principal is a filter too, not a wildcard
+			if calls, _ = s.Calls(ctx, store.CallQuery{ByPrincipal: true}); len(calls) != 0 {
+				t.Errorf("expected no calls for an empty principal, got: %+v", calls)
+			}
+
+			if objects, _ = s.Objects(ctx, store.ObjectQuery{ByPrincipal: true}); len(objects) != 0 {
+				t.Errorf("expected no objects for an empty principal, got: %+v", objects)
+			}
+
+			// An update by someone else keeps the object with its creator
+			err := s.SaveObject(ctx, types.StoredObject{
+				ID: "obj_0", Tool: "payment_link", Principal: "bob", Data: json.RawMessage(`{}`), CreatedAt: base,
+				UpdatedAt: base.Add(time.Hour),
+			})
+			if err != nil {
+				t.Fatalf("failed to save object: %v", err)
+			}
+
+			if objects, _ = s.Objects(ctx, store.ObjectQuery{Principal: "ada", ByPrincipal: true}); len(objects) != 2 {
+				t.Errorf("expected ada to keep both objects, got: %+v", objects)
+			}
+		})
+	}
+}
+
+func TestStoreEventsOnce(t *testing.T) {
+	ctx := context.Background()
+
+	for name, s := range backends(t) {
+		t.Run(name, func(t *testing.T) {
+			for i, e := range []types.Event{
+				{ID: "evt_1", Type: "payout.failed", ObjectID: "pot_1", Environment: "sandbox"},
+				{ID: "evt_2", Type: "checkout.paid", ObjectID: "chk_1", Environment: "sandbox"},
+				{ID: "evt_3", Type: "payout.succeeded", ObjectID: "pot_1", Environment: "sandbox"},
+				{ID: "evt_4", Type: "payout.failed", ObjectID: "pot_2", Environment: "production"},
+			} {
+				e.ReceivedAt = base.Add(time.Duration(i) * time.Minute)
+				e.Data = json.RawMessage(`{"id":"` + e.ObjectID + `"}`)
+
+				if added, err := s.AppendEvent(ctx, e); err != nil || !added {
+					t.Fatalf("expected %s to be added, got: %v, %v", e.ID, added, err)
+				}
+			}
+
+			if added, _ := s.AppendEvent(ctx, types.Event{ID: "evt_2", Type: "checkout.paid"}); added {
+				t.Error("expected a duplicate event to be ignored")
+			}
+
+			events, err := s.Events(ctx, store.EventQuery{Type: "payout.", ObjectID: "pot_1", Environment: "sandbox"})
+			if err != nil {
+				t.Fatalf("failed to list events: %v", err)
+			}
+
+			if len(events) != 2 || events[0].ID != "evt_3" || events[1].ID != "evt_1" {
+				t.Errorf("expected the payout events newest first, got: %+v", events)
+			}
+
+			events, _ = s.Events(ctx, store.EventQuery{Window: store.Window{Limit: 1}, Environment: "sandbox"})
+			if len(events) != 1 || events[0].ID != "evt_3" || events[0].Environment != "sandbox" {
+				t.Errorf("expected the latest sandbox event, got: %+v", events)
+			}
+
+			events, _ = s.Events(ctx, store.EventQuery{Environment: "production"})
+			if len(events) != 1 || events[0].ID != "evt_4" {
+				t.Errorf("expected only the production event, got: %+v", events)
+			}
+		})
+	}
+}
+
+func TestMemoryKeepsLatest(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemory(2)
+
+	for _, id := range []string{"evt_1", "evt_2", "evt_3"} {
+		_, _ = s.AppendEvent(ctx, types.Event{ID: id, Type: "checkout.paid"})
+	}
+
+	events, _ := s.Events(ctx, store.EventQuery{})
+	if len(events) != 2 || events[0].ID != "evt_3" || events[1].ID != "evt_2" {
+		t.Errorf("expected the 2 latest events newest first, got: %+v", events)
+	}
+
+	// An evicted event may be delivered again and is then recorded as new
+	if added, _ := s.AppendEvent(ctx, types.Event{ID: "evt_1", Type: "checkout.paid"}); !added {
+		t.Error("expected an evicted event to be accepted again")
+	}
+}
+
+func TestSQLiteOpensPathWithURICharacters(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "store?mode=memory#1%.db")
+
+	s, err := store.OpenSQLite(context.Background(), path)
+	if err != nil {
+		t.Fatalf("failed to open SQLite store: %v", err)
+	}
+
+	if err := s.RecordCall(context.Background(), types.ToolCall{Tool: "payment_link", StartedAt: base}); err != nil {
+		t.Fatalf("failed to record call: %v", err)
+	}
+
+	_ = s.Close()
+
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("expected the database at %s: %v", path, err)
+	}
+}
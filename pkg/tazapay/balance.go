@@ -0,0 +1,18 @@
+package tazapay
+
+import (
+	"context"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// GetBalances fetches the available balances of the merchant account.
+func (c *Client) GetBalances(ctx context.Context) (*types.BalanceDataBlock, error) {
+	var balances types.BalanceDataBlock
+	if err := c.do(ctx, constants.GetHTTPMethod, constants.BalancePath, nil, nil, &balances); err != nil {
+		return nil, err
+	}
+
+	return &balances, nil
+}
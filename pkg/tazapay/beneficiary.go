@@ -0,0 +1,39 @@
+package tazapay
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// CreateBeneficiary registers a payout beneficiary.
+func (c *Client) CreateBeneficiary(ctx context.Context, req *types.BeneficiaryRequest) (*types.Beneficiary, error) {
+	var b types.Beneficiary
+	if err := c.do(ctx, constants.PostHTTPMethod, constants.BeneficiaryPath, nil, req, &b); err != nil {
+		return nil, err
+	}
+
+	return &b, nil
+}
+
+// GetBeneficiary fetches a beneficiary by ID.
+func (c *Client) GetBeneficiary(ctx context.Context, id string) (*types.Beneficiary, error) {
+	var b types.Beneficiary
+	if err := c.do(ctx, constants.GetHTTPMethod, constants.BeneficiaryPath+"/"+url.PathEscape(id), nil, nil, &b); err != nil {
+		return nil, err
+	}
+
+	return &b, nil
+}
+
+// ListBeneficiaries lists payout beneficiaries.
+func (c *Client) ListBeneficiaries(ctx context.Context, p types.ListParams) (*types.List[types.Beneficiary], error) {
+	var list types.List[types.Beneficiary]
+	if err := c.do(ctx, constants.GetHTTPMethod, constants.BeneficiaryPath, listQuery(p), nil, &list); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
@@ -0,0 +1,44 @@
+package tazapay
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// CreateCheckout creates a checkout session and returns its payment link.
+func (c *Client) CreateCheckout(ctx context.Context, req *types.PaymentLinkRequest) (*types.Checkout, error) {
+	var checkout types.Checkout
+	if err := c.do(ctx, constants.PostHTTPMethod, constants.CheckoutPath, nil, req, &checkout); err != nil {
+		return nil, err
+	}
+
+	return &checkout, nil
+}
+
+// GetCheckout fetches a checkout by ID.
+func (c *Client) GetCheckout(ctx context.Context, id string) (*types.Checkout, error) {
+	var checkout types.Checkout
+	if err := c.do(ctx, constants.GetHTTPMethod, constants.CheckoutPath+"/"+url.PathEscape(id), nil, nil, &checkout); err != nil {
+		return nil, err
+	}
+
+	return &checkout, nil
+}
+
+// ListCheckouts lists checkouts, optionally filtered by reference ID.
+func (c *Client) ListCheckouts(ctx context.Context, p types.ListCheckoutsParams) (*types.List[types.Checkout], error) {
+	query := listQuery(p.ListParams)
+	if p.ReferenceID != "" {
+		query.Set("reference_id", p.ReferenceID)
+	}
+
+	var list types.List[types.Checkout]
+	if err := c.do(ctx, constants.GetHTTPMethod, constants.CheckoutPath, query, nil, &list); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
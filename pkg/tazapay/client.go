@@ -0,0 +1,211 @@
+package tazapay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// Client is a typed client for the Tazapay v3 API.
+type Client struct {
+	httpClient *http.Client
+	env        types.Environment
+	authToken  string
+	logger     *slog.Logger
+	retry      RetryPolicy
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used for API calls.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// NewClient returns a client for the given environment.
+// The default HTTP client has a timeout and requests are retried with DefaultRetryPolicy.
+// authToken is the base64 encoded "key:secret" pair used for Basic auth.
+func NewClient(env types.Environment, authToken string, logger *slog.Logger, opts ...Option) *Client {
+	c := &Client{
+		httpClient: &http.Client{Timeout: constants.DefaultHTTPTimeout},
+		env:        env,
+		authToken:  authToken,
+		logger:     logger,
+		retry:      DefaultRetryPolicy(),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Environment returns the API environment the client talks to.
+func (c *Client) Environment() types.Environment {
+	return c.env
+}
+
+// envelope is the common wrapper of every Tazapay API response.
+type envelope struct {
+	Status  string          `json:"status"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+	Errors  []ErrorDetail   `json:"errors"`
+}
+
+// do sends a request and decodes the "data" field of the response into out.
+// Transient failures are retried according to the client's retry policy.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	endpoint := c.env.URL(path)
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	var jsonBody []byte
+
+	if body != nil {
+		var err error
+
+		jsonBody, err = json.Marshal(body)
+		if err != nil {
+			c.logger.Error("Failed to marshal request payload", slog.Any("error", err))
+			return fmt.Errorf("error creating request body: %w", err)
+		}
+	}
+
+	key := requestIdempotencyKey(ctx, method, path)
+
+	attempts := max(c.retry.MaxAttempts, 1)
+	if !canRetry(method, key) {
+		attempts = 1
+	}
+
+	var (
+		statusCode int
+		bodyBytes  []byte
+		delay      time.Duration
+		err        error
+	)
+
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if attempt > 1 {
+			c.logger.Warn("Retrying Tazapay API request",
+				slog.String("method", method), slog.String("path", path),
+				slog.Int("attempt", attempt), slog.Duration("delay", delay))
+
+			if sleepErr := sleep(ctx, delay); sleepErr != nil {
+				return fmt.Errorf("retry aborted: %w", sleepErr)
+			}
+		}
+
+		statusCode, bodyBytes, delay, err = c.send(ctx, method, endpoint, jsonBody, key)
+		if err != nil {
+			if attempt < attempts && isRetryableError(ctx, err) {
+				delay = c.retry.backoff(attempt)
+				continue
+			}
+
+			return err
+		}
+
+		// A Retry-After longer than the longest backoff is not worth waiting
+		// for in a tool call, so the response is returned as it is
+		if attempt < attempts && isRetryableStatus(statusCode) && delay <= c.retry.MaxBackoff {
+			if delay <= 0 {
+				delay = c.retry.backoff(attempt)
+			}
+
+			continue
+		}
+
+		break
+	}
+
+	if statusCode < constants.HTTPStatusOKMin || statusCode >= constants.HTTPStatusOKMax {
+		apiErr := newAPIError(statusCode, bodyBytes)
+		c.logger.Error("Non-success HTTP response",
+			slog.Int("status_code", statusCode),
+			slog.String("error", apiErr.Error()),
+		)
+
+		return apiErr
+	}
+
+	var env envelope
+	if err := json.Unmarshal(bodyBytes, &env); err != nil {
+		c.logger.Error("Failed to decode response JSON", slog.Any("error", err))
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	if len(env.Data) == 0 || string(env.Data) == "null" {
+		return constants.ErrNoDataInResponse
+	}
+
+	if out != nil {
+		if err := json.Unmarshal(env.Data, out); err != nil {
+			c.logger.Error("Failed to decode response data", slog.Any("error", err))
+			return fmt.Errorf("%w: %w", constants.ErrInvalidDataFormat, err)
+		}
+	}
+
+	c.logger.Info("Tazapay API request successful", slog.String("method", method), slog.String("path", path))
+
+	return nil
+}
+
+// send performs a single HTTP attempt and returns the status, the body and
+// the delay requested by a Retry-After header, if any.
+func (c *Client) send(ctx context.Context, method, endpoint string,
+	jsonBody []byte, idemKey string,
+) (int, []byte, time.Duration, error) {
+	var reqBody io.Reader = http.NoBody
+	if jsonBody != nil {
+		reqBody = bytes.NewReader(jsonBody)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+	if err != nil {
+		c.logger.Error("Failed to create HTTP request", slog.Any("error", err))
+		return 0, nil, 0, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set(constants.HeaderAccept, constants.AcceptJSON)
+	req.Header.Set(constants.HeaderContentType, constants.ContentTypeJSON)
+	req.Header.Set(constants.HeaderAuthorization, constants.AuthSchemeBasic+c.authToken)
+
+	if idemKey != "" {
+		req.Header.Set(constants.HeaderIdempotencyKey, idemKey)
+	}
+
+	c.logger.Info("Sending Tazapay API request", slog.String("method", method), slog.String("url", endpoint))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.logger.Error("HTTP request failed", slog.Any("error", err))
+		return 0, nil, 0, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		c.logger.Error("Failed to read response body", slog.Any("error", err))
+		return 0, nil, 0, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	delay, _ := retryAfter(resp)
+
+	return resp.StatusCode, bodyBytes, delay, nil
+}
@@ -0,0 +1,395 @@
+package tazapay_test
+
+import (
+	"crypto/x509"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/money"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *api.Client {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	env := types.Environment{Name: constants.EnvSandbox, BaseURL: srv.URL}
+
+	return api.NewClient(env, "dG9rZW4=", logger,
+		api.WithHTTPClient(srv.Client()),
+		api.WithRetryPolicy(api.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}),
+	)
+}
+
+func TestGetFXRate(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != constants.FxPayoutPath {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+
+		if got := r.URL.Query().Get("initial_currency"); got != "USD" {
+			t.Errorf("expected initial_currency USD, got: %s", got)
+		}
+
+		if got := r.URL.Query().Get("amount"); got != "99.99" {
+			t.Errorf("expected amount 99.99, got: %s", got)
+		}
+
+		if got := r.Header.Get("Authorization"); got != "Basic dG9rZW4=" {
+			t.Errorf("unexpected Authorization header: %s", got)
+		}
+
+		_, _ = io.WriteString(w, `{"status":"success","data":{"exchange_rate":83.12,"converted_amount":8312}}`)
+	})
+
+	rate, err := client.GetFXRate(t.Context(), types.FXRateRequest{
+		InitialCurrency: "USD", FinalCurrency: "INR", Amount: money.FromMinor(9999, "USD"),
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if rate.ExchangeRate != 83.12 || rate.ConvertedAmount != 8312 {
+		t.Errorf("unexpected rate: %+v", rate)
+	}
+}
+
+func TestAPIErrorParsesEnvelope(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = io.WriteString(w, `{"status":"error","message":"validation failed",`+
+			`"errors":[{"code":1102,"message":"invalid currency","remarks":"invoice_currency"}]}`)
+	})
+
+	_, err := client.CreateCheckout(t.Context(), &types.PaymentLinkRequest{})
+
+	var apiErr *api.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got: %v", err)
+	}
+
+	if apiErr.StatusCode != http.StatusBadRequest || apiErr.Message != "validation failed" ||
+		len(apiErr.Errors) != 1 || apiErr.Errors[0].Code != 1102 {
+		t.Errorf("unexpected APIError: %+v", apiErr)
+	}
+
+	if !errors.Is(err, constants.ErrNonSuccessStatus) {
+		t.Errorf("expected APIError to match ErrNonSuccessStatus")
+	}
+}
+
+func TestAPIErrorKeepsUnparseableBody(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = io.WriteString(w, "<html>bad gateway</html>")
+	})
+
+	_, err := client.GetBalances(t.Context())
+
+	var apiErr *api.APIError
+	if !errors.As(err, &apiErr) || apiErr.Body != "<html>bad gateway</html>" {
+		t.Fatalf("expected APIError with raw body, got: %v", err)
+	}
+}
+
+func TestMissingData(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, `{"status":"success","message":"ok"}`)
+	})
+
+	if _, err := client.GetBalances(t.Context()); !errors.Is(err, constants.ErrNoDataInResponse) {
+		t.Errorf("expected ErrNoDataInResponse, got: %v", err)
+	}
+}
+
+func TestRetryPOSTWithIdempotencyKey(t *testing.T) {
+	var calls atomic.Int32
+
+	keys := make(chan string, 3)
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		keys <- r.Header.Get(constants.HeaderIdempotencyKey)
+
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		_, _ = io.WriteString(w, `{"status":"success","data":{"id":"chk_1","url":"https://pay.example/chk_1"}}`)
+	})
+
+	ctx := api.WithIdempotencyKey(t.Context(), "tool-call-1")
+
+	checkout, err := client.CreateCheckout(ctx, &types.PaymentLinkRequest{})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if checkout.ID != "chk_1" || calls.Load() != 3 {
+		t.Errorf("expected success after 3 attempts, got %d attempts", calls.Load())
+	}
+
+	first := <-keys
+	if first == "" || <-keys != first || <-keys != first {
+		t.Errorf("expected the same non-empty Idempotency-Key on every attempt")
+	}
+}
+
+func TestNoRetryPOSTWithoutIdempotencyKey(t *testing.T) {
+	var calls atomic.Int32
+
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	if _, err := client.CreateCheckout(t.Context(), &types.PaymentLinkRequest{}); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if calls.Load() != 1 {
+		t.Errorf("expected a single attempt, got: %d", calls.Load())
+	}
+}
+
+func TestRetryGETOnRateLimit(t *testing.T) {
+	var calls atomic.Int32
+
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set(constants.HeaderRetryAfter, "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+
+			return
+		}
+
+		_, _ = io.WriteString(w, `{"status":"success","data":{"available":[]}}`)
+	})
+
+	if _, err := client.GetBalances(t.Context()); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if calls.Load() != 2 {
+		t.Errorf("expected 2 attempts, got: %d", calls.Load())
+	}
+}
+
+func TestNoRetryBeyondMaxBackoff(t *testing.T) {
+	var calls atomic.Int32
+
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.Header().Set(constants.HeaderRetryAfter, "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	var apiErr *api.APIError
+	if _, err := client.GetBalances(t.Context()); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected the 429, got: %v", err)
+	}
+
+	if calls.Load() != 1 {
+		t.Errorf("expected a single attempt, got: %d", calls.Load())
+	}
+}
+
+// roundTripper fails every request with err.
+type roundTripper struct {
+	calls atomic.Int32
+	err   error
+}
+
+func (rt *roundTripper) RoundTrip(*http.Request) (*http.Response, error) {
+	rt.calls.Add(1)
+	return nil, rt.err
+}
+
+func TestRetryOnlyTransientErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		err   error
+		calls int32
+	}{
+		{"unexpected EOF", io.ErrUnexpectedEOF, 3},
+		{"connection reset", &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}, 3},
+		{"certificate", x509.UnknownAuthorityError{}, 1},
+		{"local failure", errors.New("no proxy configured"), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt := &roundTripper{err: tt.err}
+			logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+			env := types.Environment{Name: constants.EnvSandbox, BaseURL: "https://api.example.com"}
+
+			client := api.NewClient(env, "dG9rZW4=", logger,
+				api.WithHTTPClient(&http.Client{Transport: rt}),
+				api.WithRetryPolicy(api.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}),
+			)
+
+			if _, err := client.GetBalances(t.Context()); err == nil {
+				t.Fatal("expected error")
+			}
+
+			if rt.calls.Load() != tt.calls {
+				t.Errorf("expected %d attempts, got: %d", tt.calls, rt.calls.Load())
+			}
+		})
+	}
+}
+
+func TestQuotePayout(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != constants.FxPayoutPath {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+
+		_, _ = io.WriteString(w, `{"status":"success","data":{"exchange_rate":80,"converted_amount":8000}}`)
+	})
+
+	quote, err := client.QuotePayout(t.Context(), "usd", money.FromMinor(100000, "inr"))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if quote.ExchangeRate != 80 || quote.HoldingAmount != 1250 || quote.HoldingCurrency != "USD" {
+		t.Errorf("unexpected quote: %+v", quote)
+	}
+
+	same, err := client.QuotePayout(t.Context(), "USD", money.FromMinor(1000, "usd"))
+	if err != nil || same.ExchangeRate != 1 || same.HoldingAmount != 1000 {
+		t.Errorf("expected 1:1 quote without FX, got: %+v, %v", same, err)
+	}
+}
+
+func TestQuoteFXFixedDestination(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// The destination amount must not be sent as a source amount
+		if got := r.URL.Query().Get("amount"); got != "1.00" {
+			t.Errorf("expected a nominal amount of 1.00 USD, got: %s", got)
+		}
+
+		_, _ = io.WriteString(w, `{"status":"success","data":{"id":"fxq_api","exchange_rate":16234.5678,"converted_amount":16234.57}}`)
+	})
+
+	quote, err := client.QuoteFX(t.Context(), "usd", "idr", money.FromMinor(1000000, "IDR"), constants.FXFixedDestination)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 1,000,000 IDR / 16234.5678 = 61.597... USD, rounded up to cover the destination
+	if quote.SourceAmount != 6160 || quote.DestinationAmount != 1000000 {
+		t.Errorf("unexpected quote: %+v", quote)
+	}
+
+	// The API's quote is for 1 USD, not for the amount quoted here
+	if quote.QuoteID != "" {
+		t.Errorf("expected no quote ID for a fixed destination, got: %s", quote.QuoteID)
+	}
+
+	if quote.ExchangeRate != 16234.5678 || quote.QuotedAt == "" {
+		t.Errorf("expected full-precision rate and a timestamp, got: %+v", quote)
+	}
+}
+
+func TestQuoteFXWithoutQuoteID(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, `{"status":"success","data":{"exchange_rate":83.12,"converted_amount":8312}}`)
+	})
+
+	quote, err := client.QuoteFX(t.Context(), "USD", "INR", money.FromMinor(10000, "USD"), constants.FXFixedSource)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if quote.QuoteID != "" || quote.DestinationAmount != 831200 {
+		t.Errorf("expected no made-up quote ID, got: %+v", quote)
+	}
+}
+
+func TestSearchCustomersPagesAndMatches(t *testing.T) {
+	var calls atomic.Int32
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+
+		if r.URL.Query().Get("email") != "" {
+			t.Errorf("expected no email filter for a name query, got: %s", r.URL.RawQuery)
+		}
+
+		if r.URL.Query().Get("starting_after") == "" {
+			_, _ = io.WriteString(w, `{"status":"success","data":{"data":[`+
+				`{"id":"cus_1","name":"Ada Lovelace","email":"ada@example.com"},`+
+				`{"id":"cus_2","name":"Alan Turing","email":"alan@example.com"}],"has_more":true}}`)
+
+			return
+		}
+
+		if got := r.URL.Query().Get("starting_after"); got != "cus_2" {
+			t.Errorf("expected cursor cus_2, got: %s", got)
+		}
+
+		_, _ = io.WriteString(w, `{"status":"success","data":{"data":[`+
+			`{"id":"cus_3","name":"Grace Hopper","email":"grace@example.com","reference_id":"LOVELACE-2"}],"has_more":false}}`)
+	})
+
+	result, err := client.SearchCustomers(t.Context(), " lovelace ")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if calls.Load() != 2 || result.Truncated {
+		t.Errorf("expected 2 complete pages, got %d calls, truncated %v", calls.Load(), result.Truncated)
+	}
+
+	if len(result.Customers) != 2 || result.Customers[0].ID != "cus_1" || result.Customers[1].ID != "cus_3" {
+		t.Errorf("unexpected matches: %+v", result.Customers)
+	}
+}
+
+func TestSearchCustomersByEmail(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("email"); got != "ada@example.com" {
+			t.Errorf("expected email filter, got: %s", r.URL.RawQuery)
+		}
+
+		_, _ = io.WriteString(w, `{"status":"success","data":{"data":[{"id":"cus_1","email":"ada@example.com"}]}}`)
+	})
+
+	result, err := client.SearchCustomers(t.Context(), "ada@example.com")
+	if err != nil || len(result.Customers) != 1 {
+		t.Errorf("expected one customer, got: %+v, %v", result, err)
+	}
+}
+
+func TestSearchCustomersByPartialEmail(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("email") != "" {
+			t.Errorf("expected no email filter for part of an email, got: %s", r.URL.RawQuery)
+		}
+
+		_, _ = io.WriteString(w, `{"status":"success","data":{"data":[`+
+			`{"id":"cus_1","email":"jane@acme.com"},{"id":"cus_2","email":"bob@example.com"}]}}`)
+	})
+
+	for _, query := range []string{"@acme.com", "jane@"} {
+		result, err := client.SearchCustomers(t.Context(), query)
+		if err != nil || len(result.Customers) != 1 || result.Customers[0].ID != "cus_1" {
+			t.Errorf("expected %q to match cus_1, got: %+v, %v", query, result, err)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package tazapay
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/validation"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// CreateCustomer creates a customer.
+func (c *Client) CreateCustomer(ctx context.Context, req *types.CustomerRequest) (*types.Customer, error) {
+	var customer types.Customer
+	if err := c.do(ctx, constants.PostHTTPMethod, constants.CustomerPath, nil, req, &customer); err != nil {
+		return nil, err
+	}
+
+	return &customer, nil
+}
+
+// UpdateCustomer changes the fields set in req and leaves the others as they are.
+func (c *Client) UpdateCustomer(ctx context.Context, id string, req *types.CustomerRequest) (*types.Customer, error) {
+	var customer types.Customer
+	if err := c.do(ctx, constants.PutHTTPMethod, constants.CustomerPath+"/"+url.PathEscape(id), nil, req, &customer); err != nil {
+		return nil, err
+	}
+
+	return &customer, nil
+}
+
+// GetCustomer fetches a customer by ID.
+func (c *Client) GetCustomer(ctx context.Context, id string) (*types.Customer, error) {
+	var customer types.Customer
+	if err := c.do(ctx, constants.GetHTTPMethod, constants.CustomerPath+"/"+url.PathEscape(id), nil, nil, &customer); err != nil {
+		return nil, err
+	}
+
+	return &customer, nil
+}
+
+// ListCustomers lists customers, optionally filtered by email or reference ID.
+func (c *Client) ListCustomers(ctx context.Context, p types.ListCustomersParams) (*types.List[types.Customer], error) {
+	query := listQuery(p.ListParams)
+	if p.Email != "" {
+		query.Set("email", p.Email)
+	}
+
+	if p.ReferenceID != "" {
+		query.Set("reference_id", p.ReferenceID)
+	}
+
+	var list types.List[types.Customer]
+	if err := c.do(ctx, constants.GetHTTPMethod, constants.CustomerPath, query, nil, &list); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+// SearchCustomers returns the customers whose name, email or reference ID
+// contains query, ignoring case. The API only filters on exact values, so a
+// full email address is sent as the email filter and anything else, including
+// part of an email such as "@acme.com", is matched against the first
+// MaxCustomerSearchPages pages.
+func (c *Client) SearchCustomers(ctx context.Context, query string) (*types.CustomerSearch, error) {
+	query = strings.TrimSpace(query)
+	result := &types.CustomerSearch{Customers: []types.Customer{}}
+
+	if validation.IsEmail(query) {
+		list, err := c.ListCustomers(ctx, types.ListCustomersParams{
+			ListParams: types.ListParams{Limit: constants.CustomerSearchPageSize},
+			Email:      query,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		result.Customers = append(result.Customers, list.Data...)
+
+		return result, nil
+	}
+
+	needle := strings.ToLower(query)
+	params := types.ListCustomersParams{ListParams: types.ListParams{Limit: constants.CustomerSearchPageSize}}
+
+	for page := 0; ; page++ {
+		if page == constants.MaxCustomerSearchPages {
+			result.Truncated = true
+			return result, nil
+		}
+
+		list, err := c.ListCustomers(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, customer := range list.Data {
+			if customerMatches(customer, needle) {
+				result.Customers = append(result.Customers, customer)
+			}
+		}
+
+		if !list.HasMore || len(list.Data) == 0 {
+			return result, nil
+		}
+
+		params.StartingAfter = list.Data[len(list.Data)-1].ID
+	}
+}
+
+// customerMatches reports whether the customer's name, email or reference contains the lowercase needle.
+func customerMatches(customer types.Customer, needle string) bool {
+	for _, field := range []string{customer.Name, customer.Email, customer.ReferenceID} {
+		if strings.Contains(strings.ToLower(field), needle) {
+			return true
+		}
+	}
+
+	return false
+}
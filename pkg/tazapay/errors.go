@@ -0,0 +1,77 @@
+package tazapay
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+)
+
+// ErrorDetail is a single entry of the "errors" array in a Tazapay error response.
+type ErrorDetail struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Remarks string `json:"remarks"`
+}
+
+// APIError is returned for non-2xx responses from the Tazapay API.
+type APIError struct {
+	StatusCode int           // HTTP status code
+	Message    string        // Top-level "message" of the error envelope
+	Errors     []ErrorDetail // Field or business errors reported by Tazapay
+	Body       string        // Raw body, set only when it could not be parsed
+}
+
+// newAPIError parses Tazapay's error envelope, keeping a truncated raw body as fallback.
+func newAPIError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{StatusCode: statusCode}
+
+	var env envelope
+	if err := json.Unmarshal(body, &env); err != nil || (env.Message == "" && len(env.Errors) == 0) {
+		raw := string(body)
+		if len(raw) > constants.MaxErrorBodyLength {
+			raw = raw[:constants.MaxErrorBodyLength] + "..."
+		}
+
+		apiErr.Body = raw
+
+		return apiErr
+	}
+
+	apiErr.Message = env.Message
+	apiErr.Errors = env.Errors
+
+	return apiErr
+}
+
+// Error implements error.
+func (e *APIError) Error() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "tazapay API error (%d %s)", e.StatusCode, http.StatusText(e.StatusCode))
+
+	if e.Message != "" {
+		b.WriteString(": " + e.Message)
+	}
+
+	for _, d := range e.Errors {
+		fmt.Fprintf(&b, "; [%d] %s", d.Code, d.Message)
+
+		if d.Remarks != "" {
+			b.WriteString(" (" + d.Remarks + ")")
+		}
+	}
+
+	if e.Body != "" {
+		b.WriteString(", body: " + e.Body)
+	}
+
+	return b.String()
+}
+
+// Unwrap lets callers keep matching on constants.ErrNonSuccessStatus.
+func (*APIError) Unwrap() error {
+	return constants.ErrNonSuccessStatus
+}
@@ -0,0 +1,95 @@
+package tazapay
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/money"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// GetFXRate fetches the payout FX rate between two currencies.
+// The amount is sent as a decimal in major units of the initial currency.
+func (c *Client) GetFXRate(ctx context.Context, req types.FXRateRequest) (*types.FXRate, error) {
+	query := url.Values{}
+	query.Set("initial_currency", req.InitialCurrency)
+	query.Set("final_currency", req.FinalCurrency)
+	query.Set("amount", req.Amount.String())
+
+	var rate types.FXRate
+	if err := c.do(ctx, constants.GetHTTPMethod, constants.FxPayoutPath, query, nil, &rate); err != nil {
+		return nil, err
+	}
+
+	return &rate, nil
+}
+
+// QuoteFX quotes a conversion from one currency to another. With fixedSide
+// "source", amount is in from and the destination amount is computed; with
+// "destination", amount is in to and the source amount needed to buy it is
+// computed, rounded up so that it always covers the destination amount.
+// QuoteID is only set when Tazapay returns one for the quoted amount, so
+// never for a fixed destination, which is priced from the rate of one unit.
+func (c *Client) QuoteFX(ctx context.Context, from, to string, amount money.Money, fixedSide string,
+) (*types.FXQuote, error) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+
+	quote := &types.FXQuote{From: from, To: to, FixedSide: fixedSide}
+
+	// The API needs a source amount. For a fixed destination it is not known
+	// before the rate, so the rate of one unit of the source currency is used
+	source := amount
+	if fixedSide == constants.FXFixedDestination {
+		var err error
+		if source, err = money.Round(1, from); err != nil {
+			return nil, err
+		}
+	}
+
+	rate, err := c.GetFXRate(ctx, types.FXRateRequest{InitialCurrency: from, FinalCurrency: to, Amount: source})
+	if err != nil {
+		return nil, err
+	}
+
+	if rate.ExchangeRate <= 0 {
+		return nil, constants.ErrInvalidDataFormat
+	}
+
+	quote.ExchangeRate = rate.ExchangeRate
+	quote.InverseRate = 1 / rate.ExchangeRate
+
+	if fixedSide == constants.FXFixedDestination {
+		sourceAmount, err := money.Ceil(amount.Float64()/rate.ExchangeRate, from)
+		if err != nil {
+			return nil, err
+		}
+
+		quote.DestinationAmount, quote.SourceAmount = amount.Minor, sourceAmount.Minor
+	} else {
+		converted := rate.ConvertedAmount
+		if converted <= 0 {
+			converted = amount.Float64() * rate.ExchangeRate
+		}
+
+		destinationAmount, err := money.Round(converted, to)
+		if err != nil {
+			return nil, err
+		}
+
+		quote.SourceAmount, quote.DestinationAmount = amount.Minor, destinationAmount.Minor
+	}
+
+	if fixedSide != constants.FXFixedDestination {
+		quote.QuoteID = rate.ID
+	}
+
+	quote.QuotedAt = rate.CreatedAt
+	if quote.QuotedAt == "" {
+		quote.QuotedAt = time.Now().UTC().Format(time.RFC3339)
+	}
+
+	return quote, nil
+}
@@ -0,0 +1,33 @@
+package tazapay
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// GetPayin fetches a payin by ID.
+func (c *Client) GetPayin(ctx context.Context, id string) (*types.Payin, error) {
+	var payin types.Payin
+	if err := c.do(ctx, constants.GetHTTPMethod, constants.PayinPath+"/"+url.PathEscape(id), nil, nil, &payin); err != nil {
+		return nil, err
+	}
+
+	return &payin, nil
+}
+
+// ListPaymentAttempts lists the payment attempts of a payin, newest first.
+func (c *Client) ListPaymentAttempts(ctx context.Context, p types.ListPaymentAttemptsParams,
+) (*types.List[types.PaymentAttempt], error) {
+	query := listQuery(p.ListParams)
+	query.Set("payin", p.Payin)
+
+	var list types.List[types.PaymentAttempt]
+	if err := c.do(ctx, constants.GetHTTPMethod, constants.PaymentAttemptPath, query, nil, &list); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
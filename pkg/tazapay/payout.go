@@ -0,0 +1,57 @@
+package tazapay
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/money"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// CreatePayout sends money from a balance to a beneficiary.
+func (c *Client) CreatePayout(ctx context.Context, req *types.PayoutRequest) (*types.Payout, error) {
+	var p types.Payout
+	if err := c.do(ctx, constants.PostHTTPMethod, constants.PayoutPath, nil, req, &p); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// GetPayout fetches a payout by ID.
+func (c *Client) GetPayout(ctx context.Context, id string) (*types.Payout, error) {
+	var p types.Payout
+	if err := c.do(ctx, constants.GetHTTPMethod, constants.PayoutPath+"/"+url.PathEscape(id), nil, nil, &p); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// QuotePayout uses the payout FX rate to estimate how much of the holding
+// currency a payout of amount will cost.
+func (c *Client) QuotePayout(ctx context.Context, holdingCurrency string, amount money.Money) (*types.PayoutQuote, error) {
+	quote := &types.PayoutQuote{
+		HoldingCurrency: strings.ToUpper(holdingCurrency),
+		PayoutCurrency:  amount.Currency,
+		PayoutAmount:    amount.Minor,
+		ExchangeRate:    1,
+		HoldingAmount:   amount.Minor,
+	}
+
+	if quote.HoldingCurrency == quote.PayoutCurrency {
+		return quote, nil
+	}
+
+	fx, err := c.QuoteFX(ctx, quote.HoldingCurrency, quote.PayoutCurrency, amount, constants.FXFixedDestination)
+	if err != nil {
+		return nil, err
+	}
+
+	quote.ExchangeRate = fx.ExchangeRate
+	quote.HoldingAmount = fx.SourceAmount
+
+	return quote, nil
+}
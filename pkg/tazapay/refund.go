@@ -0,0 +1,64 @@
+package tazapay
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// CreateRefund requests a full or partial refund.
+func (c *Client) CreateRefund(ctx context.Context, req *types.RefundRequest) (*types.Refund, error) {
+	var refund types.Refund
+	if err := c.do(ctx, constants.PostHTTPMethod, constants.RefundPath, nil, req, &refund); err != nil {
+		return nil, err
+	}
+
+	return &refund, nil
+}
+
+// GetRefund fetches a refund by ID.
+func (c *Client) GetRefund(ctx context.Context, id string) (*types.Refund, error) {
+	var refund types.Refund
+	if err := c.do(ctx, constants.GetHTTPMethod, constants.RefundPath+"/"+url.PathEscape(id), nil, nil, &refund); err != nil {
+		return nil, err
+	}
+
+	return &refund, nil
+}
+
+// ListRefunds lists refunds, optionally filtered by payin.
+func (c *Client) ListRefunds(ctx context.Context, p types.ListRefundsParams) (*types.List[types.Refund], error) {
+	query := listQuery(p.ListParams)
+	if p.Payin != "" {
+		query.Set("payin", p.Payin)
+	}
+
+	var list types.List[types.Refund]
+	if err := c.do(ctx, constants.GetHTTPMethod, constants.RefundPath, query, nil, &list); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+// listQuery encodes the shared pagination parameters.
+func listQuery(p types.ListParams) url.Values {
+	query := url.Values{}
+
+	if p.Limit > 0 {
+		query.Set("limit", strconv.Itoa(p.Limit))
+	}
+
+	if p.StartingAfter != "" {
+		query.Set("starting_after", p.StartingAfter)
+	}
+
+	if p.EndingBefore != "" {
+		query.Set("ending_before", p.EndingBefore)
+	}
+
+	return query
+}
@@ -0,0 +1,137 @@
+package tazapay
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+)
+
+// RetryPolicy controls how failed API calls are retried.
+type RetryPolicy struct {
+	MaxAttempts    int           // Total attempts including the first; 1 disables retries
+	InitialBackoff time.Duration // Backoff before the first retry
+	MaxBackoff     time.Duration // Upper bound for a single backoff
+}
+
+// DefaultRetryPolicy returns the policy used when none is configured.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    constants.DefaultRetryAttempts,
+		InitialBackoff: constants.DefaultRetryInitialBackoff,
+		MaxBackoff:     constants.DefaultRetryMaxBackoff,
+	}
+}
+
+// WithRetryPolicy sets the retry policy of the client.
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = p
+	}
+}
+
+// backoff returns the delay before the given retry (1-based) using
+// exponential backoff with full jitter.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	ceiling := p.InitialBackoff << (retry - 1)
+	if ceiling <= 0 || ceiling > p.MaxBackoff {
+		ceiling = p.MaxBackoff
+	}
+
+	if ceiling <= 0 {
+		return 0
+	}
+
+	return rand.N(ceiling) //nolint: gosec // jitter does not need a secure source
+}
+
+// isRetryableStatus reports whether a response status is worth retrying.
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
+
+// canRetry reports whether a request may be sent again. GETs are idempotent;
+// other methods are only retried when they carry an idempotency key.
+func canRetry(method, idempotencyKey string) bool {
+	return method == http.MethodGet || idempotencyKey != ""
+}
+
+// isRetryableError reports whether a transport error is worth retrying: a
+// dropped or refused connection or a network timeout may not happen again,
+// while a bad request or certificate fails the same way every time.
+func isRetryableError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+
+	// Every error of http.Client.Do is a *url.Error, itself a net.Error, so
+	// only the error it wraps tells whether the network was at fault
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr)
+}
+
+// retryAfter parses a Retry-After header given in seconds.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	secs, err := strconv.Atoi(resp.Header.Get(constants.HeaderRetryAfter))
+	if err != nil || secs < 0 {
+		return 0, false
+	}
+
+	return time.Duration(secs) * time.Second, true
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type idempotencyKey struct{}
+
+// WithIdempotencyKey attaches a base idempotency key to the context. Every
+// mutating request made with this context sends an Idempotency-Key derived
+// from it, so the retries of one tool call never repeat its side effects.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKey{}, key)
+}
+
+// requestIdempotencyKey derives a per-endpoint key from the context key,
+// so one tool call hitting several endpoints uses distinct keys.
+func requestIdempotencyKey(ctx context.Context, method, path string) string {
+	base, _ := ctx.Value(idempotencyKey{}).(string)
+	if base == "" || method == http.MethodGet {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(base + "|" + method + "|" + path))
+
+	return hex.EncodeToString(sum[:constants.IdempotencyKeyBytes])
+}
@@ -0,0 +1,170 @@
+// Package toolerror turns tool failures into MCP results with IsError set,
+// so the model sees what went wrong and how to recover instead of a
+// generic protocol error.
+package toolerror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/pkg/validation"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// ToolError is the classified form of a tool failure.
+type ToolError struct {
+	Code      string                  `json:"code"`
+	Message   string                  `json:"message"`
+	Hint      string                  `json:"hint"`
+	Retryable bool                    `json:"retryable"`
+	Status    int                     `json:"status,omitempty"` // HTTP status of a Tazapay API error
+	Fields    []validation.FieldError `json:"fields,omitempty"`
+	Details   []api.ErrorDetail       `json:"details,omitempty"` // Errors reported by Tazapay
+}
+
+// argumentErrors are the sentinels returned for arguments the model can correct.
+var argumentErrors = []error{
+	constants.ErrInvalidArguments,
+	constants.ErrInvalidType,
+	constants.ErrMissingRequiredField,
+	constants.ErrMissingRefundTarget,
+	constants.ErrMissingRefundCurrency,
+	constants.ErrNonPositiveAmount,
+	constants.ErrInvalidAmount,
+	constants.ErrAmountPrecision,
+	constants.ErrInvalidFixedSide,
+	constants.ErrInvalidBeneficiaryType,
+	constants.ErrInvalidDestinationType,
+	constants.ErrMissingCheckoutLookup,
+	constants.ErrMissingBankAccount,
+	constants.ErrMissingCustomer,
+	constants.ErrNoCustomerChanges,
+}
+
+// Classify maps err to an error code and a remediation hint.
+func Classify(err error) ToolError {
+	te := ToolError{Message: err.Error()}
+
+	var (
+		fields validation.Errors
+		apiErr *api.APIError
+		netErr net.Error
+	)
+
+	switch {
+	case errors.As(err, &fields):
+		te.Code, te.Hint = constants.ErrCodeInvalidArguments, constants.HintInvalidArguments
+		te.Message = constants.ErrInvalidArguments.Error() // The fields are listed separately
+		te.Fields = fields
+
+	case isAny(err, argumentErrors):
+		te.Code, te.Hint = constants.ErrCodeInvalidArguments, constants.HintInvalidArguments
+
+	case isAny(err, []error{
+		constants.ErrConfirmationInvalid, constants.ErrConfirmationExpired, constants.ErrConfirmationMismatch,
+	}):
+		te.Code, te.Hint = constants.ErrCodeConfirmation, constants.HintConfirmation
+
+	case errors.Is(err, constants.ErrInsufficientScope):
+		te.Code, te.Hint = constants.ErrCodeForbidden, constants.HintForbidden
+
+	case errors.Is(err, constants.ErrCheckoutNotFound):
+		te.Code, te.Hint = constants.ErrCodeNotFound, constants.HintNotFound
+
+	case errors.As(err, &apiErr):
+		te = classifyAPIError(te, apiErr)
+
+	case errors.Is(err, context.Canceled):
+		te.Code, te.Hint = constants.ErrCodeCancelled, constants.HintCancelled
+
+	case errors.Is(err, context.DeadlineExceeded):
+		te.Code, te.Hint, te.Retryable = constants.ErrCodeTimeout, constants.HintTimeout, true
+
+	case errors.As(err, &netErr):
+		te.Code, te.Hint, te.Retryable = constants.ErrCodeUnavailable, constants.HintUnavailable, true
+
+	case isAny(err, []error{
+		constants.ErrNoDataInResponse, constants.ErrInvalidDataFormat, constants.ErrMissingPaymentLink,
+	}):
+		te.Code, te.Hint = constants.ErrCodeUnexpectedResponse, constants.HintUnexpected
+
+	default:
+		te.Code, te.Hint = constants.ErrCodeInternal, constants.HintInternal
+	}
+
+	return te
+}
+
+// classifyAPIError maps a Tazapay API error by its HTTP status.
+func classifyAPIError(te ToolError, apiErr *api.APIError) ToolError {
+	te.Status = apiErr.StatusCode
+	te.Details = apiErr.Errors
+
+	switch code := apiErr.StatusCode; {
+	case code == http.StatusUnauthorized || code == http.StatusForbidden:
+		te.Code, te.Hint = constants.ErrCodeUnauthorized, constants.HintUnauthorized
+
+	case code == http.StatusNotFound:
+		te.Code, te.Hint = constants.ErrCodeNotFound, constants.HintNotFound
+
+	case code == http.StatusConflict:
+		te.Code, te.Hint = constants.ErrCodeConflict, constants.HintConflict
+
+	case code == http.StatusTooManyRequests:
+		te.Code, te.Hint, te.Retryable = constants.ErrCodeRateLimited, constants.HintRateLimited, true
+
+	case code >= http.StatusInternalServerError:
+		te.Code, te.Hint, te.Retryable = constants.ErrCodeUnavailable, constants.HintUnavailable, true
+
+	default:
+		te.Code, te.Hint = constants.ErrCodeRejected, constants.HintRejected
+	}
+
+	return te
+}
+
+// Result converts the error into a tool result with IsError set. The text
+// names the error code, the message and the hint; the error is also attached
+// under constants.ToolErrorMetaKey in _meta. It is not sent as structured
+// content, which clients would validate against the tool's output schema.
+func (te ToolError) Result(env types.Environment) *mcp.CallToolResult {
+	result := mcp.NewToolResultError(utils.TagEnvironment(env, te.Text()))
+	result.Meta = mcp.NewMetaFromMap(map[string]any{constants.ToolErrorMetaKey: te})
+
+	return result
+}
+
+// Text renders the error for the model.
+func (te ToolError) Text() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Error (%s): %s", te.Code, te.Message)
+
+	for _, fe := range te.Fields {
+		b.WriteString("\n- " + fe.Error())
+	}
+
+	b.WriteString("\nHint: " + te.Hint)
+
+	return b.String()
+}
+
+// isAny reports whether err matches any of targets.
+func isAny(err error, targets []error) bool {
+	for _, target := range targets {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,76 @@
+package toolerror_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/toolerror"
+	"github.com/tazapay/tazapay-mcp-server/pkg/validation"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		err       error
+		code      string
+		retryable bool
+	}{
+		{fmt.Errorf("%w: amount", constants.ErrInvalidType), constants.ErrCodeInvalidArguments, false},
+		{constants.ErrConfirmationExpired, constants.ErrCodeConfirmation, false},
+		{&api.APIError{StatusCode: http.StatusUnauthorized}, constants.ErrCodeUnauthorized, false},
+		{fmt.Errorf("CreatePayout failed: %w", &api.APIError{StatusCode: http.StatusBadRequest}), constants.ErrCodeRejected, false},
+		{&api.APIError{StatusCode: http.StatusTooManyRequests}, constants.ErrCodeRateLimited, true},
+		{&api.APIError{StatusCode: http.StatusBadGateway}, constants.ErrCodeUnavailable, true},
+		{fmt.Errorf("retry aborted: %w", context.DeadlineExceeded), constants.ErrCodeTimeout, true},
+		{constants.ErrNoDataInResponse, constants.ErrCodeUnexpectedResponse, false},
+		{fmt.Errorf("boom"), constants.ErrCodeInternal, false},
+	}
+
+	for _, tt := range tests {
+		te := toolerror.Classify(tt.err)
+		if te.Code != tt.code || te.Retryable != tt.retryable || te.Hint == "" {
+			t.Errorf("Classify(%v) = %+v, want code %s retryable %v", tt.err, te, tt.code, tt.retryable)
+		}
+	}
+}
+
+func TestRetryHintsCheckBeforeRepeatingWrites(t *testing.T) {
+	// Every tool call gets its own idempotency key, so a repeated write is not deduplicated
+	for _, err := range []error{context.DeadlineExceeded, &api.APIError{StatusCode: http.StatusServiceUnavailable}} {
+		if hint := toolerror.Classify(err).Hint; !strings.Contains(hint, "check with a get or list tool") {
+			t.Errorf("expected the hint for %v to ask for a check before retrying, got: %s", err, hint)
+		}
+	}
+}
+
+func TestResultListsFields(t *testing.T) {
+	v := validation.New()
+	v.Currency("invoice_currency", "dollars")
+
+	te := toolerror.Classify(fmt.Errorf("validate: %w", v.Err()))
+	if len(te.Fields) != 1 {
+		t.Fatalf("expected one field error, got: %+v", te)
+	}
+
+	result := te.Result(types.Environment{Name: constants.EnvSandbox})
+	if !result.IsError || result.StructuredContent != nil {
+		t.Fatalf("expected an error result without structured content, got: %+v", result)
+	}
+
+	text := result.Content[0].(mcp.TextContent).Text
+	if !strings.HasPrefix(text, "[sandbox] Error (invalid_arguments)") || !strings.Contains(text, "- invoice_currency:") ||
+		!strings.Contains(text, "Hint: ") {
+		t.Errorf("unexpected text: %s", text)
+	}
+
+	if _, ok := result.Meta.AdditionalFields[constants.ToolErrorMetaKey]; !ok {
+		t.Errorf("expected the error in _meta, got: %+v", result.Meta)
+	}
+}
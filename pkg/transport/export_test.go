@@ -0,0 +1,15 @@
+package transport
+
+import (
+	"net/http"
+
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// RegisterHealthRoutes exposes registerHealthRoutes to the tests.
+var RegisterHealthRoutes = registerHealthRoutes
+
+// InterceptHTTP exposes Interceptor.http to the tests.
+func InterceptHTTP(i *Interceptor, next http.Handler, sessions server.SessionIdManager) http.Handler {
+	return i.http(next, sessions)
+}
@@ -0,0 +1,153 @@
+package transport
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+)
+
+// Interceptor answers JSON-RPC requests that mcp-go does not route, such as
+// resources/subscribe, before they reach the MCP server. The SSE transport
+// queues responses on the session's event stream, which is out of reach, so
+// only stdio and streamable HTTP requests are intercepted.
+type Interceptor struct {
+	Methods []string
+	Handle  func(ctx context.Context, sessionID string, message json.RawMessage) mcp.JSONRPCMessage
+}
+
+// intercepts reports whether message is a request the interceptor answers.
+func (i *Interceptor) intercepts(message []byte) bool {
+	var req struct {
+		ID     any    `json:"id"`
+		Method string `json:"method"`
+	}
+
+	return json.Unmarshal(message, &req) == nil && req.ID != nil && slices.Contains(i.Methods, req.Method)
+}
+
+// stdio answers the intercepted requests read from stdin and passes the other
+// lines on to the returned reader. Writes to the returned writer and the
+// answers are serialized, so messages never interleave on stdout.
+func (i *Interceptor) stdio(ctx context.Context, stdin io.Reader, stdout io.Writer) (io.Reader, io.Writer) {
+	if i == nil {
+		return stdin, stdout
+	}
+
+	pr, pw := io.Pipe()
+	out := &lockedWriter{w: stdout}
+
+	go func() {
+		reader := bufio.NewReader(stdin)
+
+		for {
+			line, err := reader.ReadBytes('\n')
+
+			switch {
+			case i.intercepts(line):
+				go out.writeMessage(i.Handle(ctx, constants.StdioSessionID, line))
+
+			case len(line) > 0:
+				if _, err := pw.Write(line); err != nil {
+					return
+				}
+			}
+
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+	}()
+
+	return pr, out
+}
+
+// http answers the intercepted requests posted to the streamable HTTP
+// endpoint and passes everything else on to next. Intercepted requests must
+// carry a session ID issued by sessions, so no one can subscribe on behalf
+// of a session that does not exist.
+func (i *Interceptor) http(next http.Handler, sessions server.SessionIdManager) http.Handler {
+	if i == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, constants.MaxMCPBodyBytes))
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+
+			http.Error(w, "failed to read request body", http.StatusBadRequest)
+
+			return
+		}
+
+		if !i.intercepts(body) {
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		sessionID := r.Header.Get(server.HeaderKeySessionID)
+		if sessionID == "" {
+			http.Error(w, "missing "+server.HeaderKeySessionID+" header", http.StatusBadRequest)
+			return
+		}
+
+		switch terminated, err := sessions.Validate(sessionID); {
+		case err != nil:
+			http.Error(w, "unknown session", http.StatusNotFound)
+			return
+
+		case terminated:
+			http.Error(w, "session terminated", http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set(constants.HeaderContentType, constants.ContentTypeJSON)
+		_ = json.NewEncoder(w).Encode(i.Handle(r.Context(), sessionID, body))
+	})
+}
+
+// lockedWriter serializes writes, each of which is a whole message.
+type lockedWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (l *lockedWriter) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.w.Write(p)
+}
+
+// writeMessage writes a message as one line.
+func (l *lockedWriter) writeMessage(message mcp.JSONRPCMessage) {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return
+	}
+
+	_, _ = l.Write(append(data, '\n'))
+}
@@ -0,0 +1,195 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/auth"
+)
+
+// Config represents the transport configuration.
+type Config struct {
+	Mode            string        // "stdio", "sse" or "http"; defaults to "stdio"
+	Addr            string        // Listen address for the network transports
+	BaseURL         string        // Public base URL advertised to SSE clients; optional
+	ShutdownTimeout time.Duration // Time allowed for in-flight requests on shutdown
+
+	Auth         auth.Authenticator     // Inbound authenticator; nil disables authentication
+	AuthMetadata *auth.ResourceMetadata // Published at the protected resource metadata path when set
+
+	Intercept *Interceptor // Answers requests mcp-go does not route, over stdio and HTTP; optional
+}
+
+// ParseMode normalizes a transport name and rejects unknown values.
+func ParseMode(mode string) (string, error) {
+	switch m := strings.ToLower(strings.TrimSpace(mode)); m {
+	case "":
+		return constants.TransportStdio, nil
+
+	case constants.TransportStdio, constants.TransportSSE, constants.TransportHTTP:
+		return m, nil
+
+	default:
+		return "", fmt.Errorf("%w: %q", constants.ErrInvalidTransport, mode)
+	}
+}
+
+// Serve runs the MCP server on the configured transport until ctx is cancelled.
+func Serve(ctx context.Context, s *server.MCPServer, cfg Config, logger *slog.Logger) error {
+	switch cfg.Mode {
+	case constants.TransportSSE, constants.TransportHTTP:
+		return serveNetwork(ctx, s, cfg, logger)
+
+	default:
+		logger.Info("Serving MCP over stdio")
+
+		stdin, stdout := cfg.Intercept.stdio(ctx, os.Stdin, os.Stdout)
+
+		err := server.NewStdioServer(s).Listen(ctx, stdin, stdout)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			return fmt.Errorf("stdio server: %w", err)
+		}
+
+		return nil
+	}
+}
+
+// shutdowner is implemented by both the SSE and the streamable HTTP servers.
+type shutdowner interface {
+	Shutdown(ctx context.Context) error
+}
+
+// serveNetwork serves SSE or streamable HTTP together with health endpoints
+// and shuts down gracefully once ctx is cancelled.
+func serveNetwork(ctx context.Context, s *server.MCPServer, cfg Config, logger *slog.Logger) error {
+	addr := cfg.Addr
+	if addr == "" {
+		addr = constants.DefaultListenAddr
+	}
+
+	mux := http.NewServeMux()
+	httpServer := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: constants.ReadHeaderTimeout,
+	}
+
+	var mcpServer shutdowner
+
+	if cfg.Mode == constants.TransportSSE {
+		sse := server.NewSSEServer(s,
+			server.WithBaseURL(cfg.BaseURL),
+			server.WithSSEEndpoint(constants.SSEEndpointPath),
+			server.WithMessageEndpoint(constants.MessageEndpointPath),
+			server.WithHTTPServer(httpServer),
+		)
+		mux.Handle(constants.SSEEndpointPath, protect(sse.SSEHandler(), cfg, logger))
+		mux.Handle(constants.MessageEndpointPath, protect(sse.MessageHandler(), cfg, logger))
+		mcpServer = sse
+	} else {
+		// Session IDs are tracked so that requests the interceptor answers can be
+		// checked against the sessions the server issued
+		sessions := &server.InsecureStatefulSessionIdManager{}
+		streamable := server.NewStreamableHTTPServer(s,
+			server.WithEndpointPath(constants.MCPEndpointPath),
+			server.WithStreamableHTTPServer(httpServer),
+			server.WithSessionIdManager(sessions),
+		)
+		mux.Handle(constants.MCPEndpointPath, protect(cfg.Intercept.http(streamable, sessions), cfg, logger))
+		mcpServer = streamable
+	}
+
+	if cfg.AuthMetadata != nil {
+		mux.Handle(auth.MetadataPath, auth.MetadataHandler(*cfg.AuthMetadata))
+	}
+
+	var ready atomic.Bool
+	registerHealthRoutes(mux, &ready)
+
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- httpServer.Serve(listener)
+	}()
+
+	ready.Store(true)
+	logger.Info("Serving MCP over HTTP", slog.String("transport", cfg.Mode), slog.String("addr", listener.Addr().String()))
+
+	select {
+	case err := <-errCh:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+
+		return fmt.Errorf("%s server: %w", cfg.Mode, err)
+
+	case <-ctx.Done():
+	}
+
+	ready.Store(false)
+	logger.Info("Shutting down MCP server", slog.String("transport", cfg.Mode))
+
+	timeout := cfg.ShutdownTimeout
+	if timeout <= 0 {
+		timeout = constants.DefaultShutdownTimeout
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+	defer cancel()
+
+	if err := mcpServer.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("graceful shutdown failed: %w", err)
+	}
+
+	return nil
+}
+
+// protect wraps an MCP endpoint with bearer authentication when it is configured,
+// so unauthenticated requests never reach the MCP session or tool handlers.
+func protect(h http.Handler, cfg Config, logger *slog.Logger) http.Handler {
+	if cfg.Auth == nil {
+		return h
+	}
+
+	metadataURL := ""
+	if cfg.AuthMetadata != nil && cfg.BaseURL != "" {
+		metadataURL = strings.TrimRight(cfg.BaseURL, "/") + auth.MetadataPath
+	}
+
+	return auth.Middleware(h, cfg.Auth, metadataURL, logger)
+}
+
+// registerHealthRoutes adds liveness and readiness probes to the mux.
+// Readiness flips to 503 as soon as shutdown starts so load balancers drain the instance.
+func registerHealthRoutes(mux *http.ServeMux, ready *atomic.Bool) {
+	mux.HandleFunc(constants.HealthzPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	})
+
+	mux.HandleFunc(constants.ReadyzPath, func(w http.ResponseWriter, _ *http.Request) {
+		if !ready.Load() {
+			http.Error(w, "not ready", http.StatusServiceUnavailable)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ready"))
+	})
+}
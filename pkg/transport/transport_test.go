@@ -0,0 +1,130 @@
+package transport_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/transport"
+)
+
+func TestParseMode(t *testing.T) {
+	for _, tc := range []struct {
+		mode    string
+		want    string
+		wantErr bool
+	}{
+		{mode: "", want: constants.TransportStdio},
+		{mode: "stdio", want: constants.TransportStdio},
+		{mode: " SSE ", want: constants.TransportSSE},
+		{mode: "http", want: constants.TransportHTTP},
+		{mode: "grpc", wantErr: true},
+		{mode: "streamable-http", wantErr: true},
+	} {
+		t.Run(tc.mode, func(t *testing.T) {
+			got, err := transport.ParseMode(tc.mode)
+
+			if tc.wantErr {
+				if !errors.Is(err, constants.ErrInvalidTransport) {
+					t.Errorf("expected ErrInvalidTransport, got: %q, %v", got, err)
+				}
+
+				return
+			}
+
+			if err != nil || got != tc.want {
+				t.Errorf("expected %q, got: %q, %v", tc.want, got, err)
+			}
+		})
+	}
+}
+
+func TestHealthRoutes(t *testing.T) {
+	var ready atomic.Bool
+
+	mux := http.NewServeMux()
+	transport.RegisterHealthRoutes(mux, &ready)
+
+	for _, tc := range []struct {
+		name  string
+		path  string
+		ready bool
+		want  int
+	}{
+		{name: "live while starting", path: constants.HealthzPath, want: http.StatusOK},
+		{name: "live when ready", path: constants.HealthzPath, ready: true, want: http.StatusOK},
+		{name: "not ready before serving or after shutdown", path: constants.ReadyzPath, want: http.StatusServiceUnavailable},
+		{name: "ready", path: constants.ReadyzPath, ready: true, want: http.StatusOK},
+		{name: "unknown path", path: "/status", ready: true, want: http.StatusNotFound},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ready.Store(tc.ready)
+
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.path, nil))
+
+			if rec.Code != tc.want {
+				t.Errorf("expected %d for %s, got: %d", tc.want, tc.path, rec.Code)
+			}
+		})
+	}
+}
+
+func TestInterceptorChecksSessionsAndBodySize(t *testing.T) {
+	sessions := &server.InsecureStatefulSessionIdManager{}
+	known := sessions.Generate()
+
+	passed := 0
+	next := http.HandlerFunc(func(http.ResponseWriter, *http.Request) { passed++ })
+
+	intercept := &transport.Interceptor{
+		Methods: []string{constants.MethodResourcesSubscribe},
+		Handle: func(_ context.Context, _ string, _ json.RawMessage) mcp.JSONRPCMessage {
+			return mcp.NewJSONRPCResultResponse(mcp.NewRequestId(1), mcp.EmptyResult{})
+		},
+	}
+	h := transport.InterceptHTTP(intercept, next, sessions)
+
+	subscribe := `{"jsonrpc":"2.0","id":1,"method":"resources/subscribe","params":{"uri":"tazapay://balance"}}`
+
+	for _, tc := range []struct {
+		name    string
+		session string
+		body    string
+		want    int
+	}{
+		{name: "known session", session: known, body: subscribe, want: http.StatusOK},
+		{name: "made-up session", session: "mcp-session-00000000-0000-0000-0000-000000000000", body: subscribe,
+			want: http.StatusNotFound},
+		{name: "missing session", body: subscribe, want: http.StatusBadRequest},
+		{name: "oversized body", session: known, body: strings.Repeat(" ", constants.MaxMCPBodyBytes+1),
+			want: http.StatusRequestEntityTooLarge},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, constants.MCPEndpointPath, strings.NewReader(tc.body))
+			if tc.session != "" {
+				req.Header.Set(server.HeaderKeySessionID, tc.session)
+			}
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tc.want {
+				t.Errorf("expected status %d, got: %d %s", tc.want, rec.Code, rec.Body.String())
+			}
+		})
+	}
+
+	if passed != 0 {
+		t.Errorf("expected no request to reach the MCP server, got %d", passed)
+	}
+}
@@ -0,0 +1,222 @@
+package utils
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/money"
+	"github.com/tazapay/tazapay-mcp-server/pkg/validation"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// OptionalString returns the string argument for field, or "" when it is absent.
+func OptionalString(logger *slog.Logger, args map[string]any, field string) (string, error) {
+	v, present := args[field]
+	if !present || v == nil {
+		return "", nil
+	}
+
+	s, ok := v.(string)
+	if !ok {
+		return "", WrapFieldTypeError(logger, field)
+	}
+
+	return s, nil
+}
+
+// OptionalNumber returns the numeric argument for field, or 0 when it is absent.
+func OptionalNumber(logger *slog.Logger, args map[string]any, field string) (float64, error) {
+	v, present := args[field]
+	if !present || v == nil {
+		return 0, nil
+	}
+
+	n, ok := v.(float64)
+	if !ok {
+		return 0, WrapFieldTypeError(logger, field)
+	}
+
+	return n, nil
+}
+
+// OptionalAmount returns the amount argument for field in minor units of
+// currency, or a zero Money when it is absent. The amount may be given as a
+// number or as a decimal string such as "10.50".
+func OptionalAmount(logger *slog.Logger, args map[string]any, field, currency string) (money.Money, error) {
+	var (
+		m   money.Money
+		err error
+	)
+
+	switch v := args[field].(type) {
+	case nil:
+		return money.FromMinor(0, currency), nil
+
+	case float64:
+		m, err = money.FromFloat(v, currency)
+
+	case string:
+		m, err = money.Parse(v, currency)
+
+	default:
+		return money.Money{}, WrapFieldTypeError(logger, field)
+	}
+
+	if err != nil {
+		return money.Money{}, fmt.Errorf("%s: %w", field, err)
+	}
+
+	return m, nil
+}
+
+// RequiredAmount is like OptionalAmount but requires an amount greater than zero.
+func RequiredAmount(logger *slog.Logger, args map[string]any, field, currency string) (money.Money, error) {
+	m, err := OptionalAmount(logger, args, field, currency)
+	if err != nil {
+		return m, err
+	}
+
+	if !m.IsPositive() {
+		return m, fmt.Errorf("%s: %w", field, constants.ErrNonPositiveAmount)
+	}
+
+	return m, nil
+}
+
+// ListParamsFromArgs extracts the shared pagination arguments of list tools.
+func ListParamsFromArgs(logger *slog.Logger, args map[string]any) (types.ListParams, error) {
+	var p types.ListParams
+
+	limit, err := OptionalNumber(logger, args, constants.LimitField)
+	if err != nil {
+		return p, err
+	}
+
+	p.Limit = int(limit)
+	if p.Limit <= 0 {
+		p.Limit = constants.DefaultListLimit
+	}
+
+	p.Limit = min(p.Limit, constants.MaxListLimit)
+
+	if p.StartingAfter, err = OptionalString(logger, args, constants.StartingAfterField); err != nil {
+		return p, err
+	}
+
+	return p, nil
+}
+
+// RequiredString returns a non-empty string argument or a field error.
+func RequiredString(logger *slog.Logger, args map[string]any, field string) (string, error) {
+	s, ok := args[field].(string)
+	if !ok {
+		return "", WrapFieldTypeError(logger, field)
+	}
+
+	if s == "" {
+		return "", fmt.Errorf("%w: %s", constants.ErrMissingRequiredField, field)
+	}
+
+	return s, nil
+}
+
+// OptionalStringMap returns an object argument whose values are all strings,
+// or nil when it is absent.
+func OptionalStringMap(logger *slog.Logger, args map[string]any, field string) (map[string]string, error) {
+	v, present := args[field]
+	if !present || v == nil {
+		return nil, nil
+	}
+
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return nil, WrapFieldTypeError(logger, field)
+	}
+
+	out := make(map[string]string, len(obj))
+
+	for k, val := range obj {
+		s, ok := val.(string)
+		if !ok {
+			return nil, WrapFieldTypeError(logger, field+"."+k)
+		}
+
+		out[k] = s
+	}
+
+	return out, nil
+}
+
+// ValidAmount extracts a positive amount of currency and records any problem
+// on v. It is skipped when currencyField is already invalid, since the
+// currency decides how many decimal places the amount may have.
+func ValidAmount(logger *slog.Logger, v *validation.Validator, args map[string]any,
+	field, currencyField, currency string,
+) money.Money {
+	if !v.Valid(currencyField) {
+		return money.Money{}
+	}
+
+	m, err := OptionalAmount(logger, args, field, currency)
+	if err != nil {
+		v.Check(field, err)
+		return m
+	}
+
+	v.Amount(field, m)
+
+	return m
+}
+
+// OptionalStringList returns the array-of-strings argument for field, or nil when it is absent.
+func OptionalStringList(logger *slog.Logger, args map[string]any, field string) ([]string, error) {
+	v, present := args[field]
+	if !present || v == nil {
+		return nil, nil
+	}
+
+	list, ok := v.([]any)
+	if !ok {
+		return nil, WrapFieldTypeError(logger, field)
+	}
+
+	out := make([]string, 0, len(list))
+
+	for i, item := range list {
+		s, ok := item.(string)
+		if !ok {
+			return nil, WrapFieldTypeError(logger, fmt.Sprintf("%s[%d]", field, i))
+		}
+
+		out = append(out, s)
+	}
+
+	return out, nil
+}
+
+// OptionalObjectList returns the array-of-objects argument for field, or nil when it is absent.
+func OptionalObjectList(logger *slog.Logger, args map[string]any, field string) ([]map[string]any, error) {
+	v, present := args[field]
+	if !present || v == nil {
+		return nil, nil
+	}
+
+	list, ok := v.([]any)
+	if !ok {
+		return nil, WrapFieldTypeError(logger, field)
+	}
+
+	out := make([]map[string]any, 0, len(list))
+
+	for i, item := range list {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			return nil, WrapFieldTypeError(logger, fmt.Sprintf("%s[%d]", field, i))
+		}
+
+		out = append(out, obj)
+	}
+
+	return out, nil
+}
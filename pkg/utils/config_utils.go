@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// GetStringList reads a list setting that may be given either as a YAML list
+// in the config file or as a comma-separated environment variable.
+func GetStringList(key string) []string {
+	var raw []string
+
+	switch v := viper.Get(key).(type) {
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				raw = append(raw, s)
+			}
+		}
+
+	case []string:
+		raw = v
+
+	default:
+		raw = strings.Split(viper.GetString(key), ",")
+	}
+
+	out := make([]string, 0, len(raw))
+
+	for _, s := range raw {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// ResolveEnvironment maps the TAZAPAY_ENVIRONMENT setting to an API environment.
+// - "production" (or empty) uses the production API.
+// - "sandbox" uses the sandbox API.
+// - An absolute http(s) URL is used as a custom base URL.
+func ResolveEnvironment(value string) (types.Environment, error) {
+	value = strings.TrimSpace(value)
+
+	switch strings.ToLower(value) {
+	case "", constants.EnvProduction:
+		return types.Environment{Name: constants.EnvProduction, BaseURL: constants.ProdBaseURL}, nil
+
+	case constants.EnvSandbox:
+		return types.Environment{Name: constants.EnvSandbox, BaseURL: constants.SandboxBaseURL}, nil
+	}
+
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return types.Environment{}, fmt.Errorf("%w: %q", constants.ErrInvalidEnvironment, value)
+	}
+
+	return types.Environment{Name: constants.EnvCustom, BaseURL: strings.TrimRight(value, "/")}, nil
+}
+
+// TagEnvironment prefixes tool output with the environment name so sandbox
+// results are never mistaken for production ones.
+func TagEnvironment(env types.Environment, text string) string {
+	return fmt.Sprintf("[%s] %s", env.Name, text)
+}
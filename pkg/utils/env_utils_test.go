@@ -0,0 +1,52 @@
+package utils_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+func TestResolveEnvironment(t *testing.T) {
+	production := types.Environment{Name: constants.EnvProduction, BaseURL: constants.ProdBaseURL}
+	sandbox := types.Environment{Name: constants.EnvSandbox, BaseURL: constants.SandboxBaseURL}
+
+	for _, tc := range []struct {
+		value   string
+		want    types.Environment
+		wantErr bool
+	}{
+		{value: "", want: production},
+		{value: "production", want: production},
+		{value: " Sandbox ", want: sandbox},
+		{
+			value: "https://api.example.test/",
+			want:  types.Environment{Name: constants.EnvCustom, BaseURL: "https://api.example.test"},
+		},
+		{
+			value: "http://localhost:8081",
+			want:  types.Environment{Name: constants.EnvCustom, BaseURL: "http://localhost:8081"},
+		},
+		{value: "staging", wantErr: true},
+		{value: "ftp://api.example.test", wantErr: true},
+		{value: "https://", wantErr: true},
+	} {
+		t.Run(tc.value, func(t *testing.T) {
+			got, err := utils.ResolveEnvironment(tc.value)
+
+			if tc.wantErr {
+				if !errors.Is(err, constants.ErrInvalidEnvironment) {
+					t.Errorf("expected ErrInvalidEnvironment, got: %+v, %v", got, err)
+				}
+
+				return
+			}
+
+			if err != nil || got != tc.want {
+				t.Errorf("expected %+v, got: %+v, %v", tc.want, got, err)
+			}
+		})
+	}
+}
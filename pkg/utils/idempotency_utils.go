@@ -0,0 +1,12 @@
+package utils
+
+import (
+	"crypto/rand"
+)
+
+// NewIdempotencyKey returns a random key for a single tool call. Two calls
+// with identical arguments are distinct intents and get distinct keys; only
+// the HTTP retries of one call share its key.
+func NewIdempotencyKey() string {
+	return rand.Text()
+}
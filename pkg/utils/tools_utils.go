@@ -1,45 +1,39 @@
 package utils
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/decline"
+	"github.com/tazapay/tazapay-mcp-server/pkg/money"
 	"github.com/tazapay/tazapay-mcp-server/types"
 )
 
-// GetBalances parses balance data and returns specific or all available balances.
+// FormatBalances returns specific or all available balances as text.
 // - If a currency is passed, it returns balance for that currency.
 // - If no currency is passed, it returns all available balances.
-func GetBalances(data map[string]any, currency string) (string, error) {
-	// Marshal map to JSON bytes
-	raw, err := json.Marshal(data)
-	if err != nil {
-		return "", fmt.Errorf("failed to marshal balance data: %w", err)
-	}
-
-	var result types.BalanceResponse
-	// Unmarshal into the BalanceResponse struct
-	if err := json.Unmarshal(raw, &result); err != nil {
-		return "", fmt.Errorf("failed to parse balance response: %w", err)
-	}
-
+func FormatBalances(data *types.BalanceDataBlock, currency string) (string, error) {
 	// Ensure data is available
-	if len(result.Data.Available) == 0 {
+	if len(data.Available) == 0 {
 		return "No balances found.", nil
 	}
 
 	// Normalize currency if provided
-	if len(currency) > 0 && currency != "" {
+	if currency != "" {
 		currencyCode := strings.ToUpper(currency)
-		for _, balance := range result.Data.Available {
+		for _, balance := range data.Available {
 			if strings.EqualFold(balance.Currency, currencyCode) {
-				amountInt, err := strconv.Atoi(balance.Amount)
+				amount, err := balanceAmount(balance)
 				if err != nil {
-					return "", fmt.Errorf("invalid amount format for %s: %w", balance.Currency, err)
+					return "", err
 				}
-				amountFloat := float64(amountInt) / 100.0
-				return fmt.Sprintf("%s balance: %.2f", balance.Currency, amountFloat), nil
+				return fmt.Sprintf("%s balance: %s", balance.Currency, amount), nil
 			}
 		}
 		return fmt.Sprintf("No balance found for currency: %s", currencyCode), nil
@@ -47,18 +41,40 @@ func GetBalances(data map[string]any, currency string) (string, error) {
 
 	// Format all balances
 	output := "Available account balances:\n"
-	for _, balance := range result.Data.Available {
-		amountInt, err := strconv.Atoi(balance.Amount)
+	for _, balance := range data.Available {
+		amount, err := balanceAmount(balance)
 		if err != nil {
-			return "", fmt.Errorf("invalid amount format for %s: %w", balance.Currency, err)
+			return "", err
 		}
-		amountFloat := float64(amountInt) / 100.0
-		output += fmt.Sprintf("- %s: %.2f\n", balance.Currency, amountFloat)
+		output += fmt.Sprintf("- %s: %s\n", balance.Currency, amount)
 	}
 	return output, nil
 }
 
+// balanceAmount formats a balance, which the API reports in minor units as a string.
+func balanceAmount(balance types.Balance) (string, error) {
+	minor, err := strconv.ParseInt(balance.Amount, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid amount format for %s: %w", balance.Currency, err)
+	}
+
+	return money.FromMinor(minor, balance.Currency).String(), nil
+}
+
+// FormatRefund returns a one-line summary of a refund.
+func FormatRefund(r *types.Refund) string {
+	out := fmt.Sprintf("Refund %s: status %s, amount %s", r.ID, r.Status, money.FromMinor(r.Amount, r.Currency).Display())
+
+	if r.Payin != "" {
+		out += ", payin " + r.Payin
+	}
+
+	if r.Reason != "" {
+		out += ", reason: " + r.Reason
+	}
 
+	return out
+}
 
 // MapToStruct converts map[string]any to any struct using JSON marshaling.
 // Pass a pointer to the output struct as `out`.
@@ -74,3 +90,269 @@ func MapToStruct(input map[string]any, out any) error {
 
 	return nil
 }
+
+// FormatBeneficiary returns a one-line summary of a beneficiary.
+func FormatBeneficiary(b *types.Beneficiary) string {
+	out := fmt.Sprintf("Beneficiary %s: %s (%s), destination %s", b.ID, b.Name, b.Type, b.DestinationDetails.Type)
+
+	d := b.DestinationDetails
+
+	switch {
+	case d.Bank != nil:
+		out += fmt.Sprintf(" %s/%s", d.Bank.Country, d.Bank.Currency)
+		if d.Bank.BankName != "" {
+			out += ", " + d.Bank.BankName
+		}
+
+		if n := len(d.Bank.AccountNumber); n > 4 {
+			out += " ****" + d.Bank.AccountNumber[n-4:]
+		}
+
+	case d.Wallet != nil:
+		out += fmt.Sprintf(" %s %s", d.Wallet.Type, d.Wallet.DepositKey)
+
+	case d.LocalPaymentNetwork != nil:
+		out += fmt.Sprintf(" %s %s", d.LocalPaymentNetwork.Type, d.LocalPaymentNetwork.DepositKey)
+	}
+
+	return out
+}
+
+// FormatCustomer returns a one-line summary of a customer.
+func FormatCustomer(c *types.Customer) string {
+	out := fmt.Sprintf("Customer %s: %s <%s> (%s)", c.ID, c.Name, c.Email, c.Country)
+
+	if c.Phone != nil && c.Phone.Number != "" {
+		out += fmt.Sprintf(", phone +%s %s", c.Phone.CallingCode, c.Phone.Number)
+	}
+
+	if c.ReferenceID != "" {
+		out += ", reference " + c.ReferenceID
+	}
+
+	return out
+}
+
+// FormatPayout returns a one-line summary of a payout.
+func FormatPayout(p *types.Payout) string {
+	out := fmt.Sprintf("Payout %s: status %s, amount %s to beneficiary %s",
+		p.ID, p.Status, money.FromMinor(p.Amount, p.Currency).Display(), p.Beneficiary)
+
+	if p.HoldingCurrency != "" && p.HoldingCurrency != p.Currency {
+		out += fmt.Sprintf(", funded from %s", p.HoldingCurrency)
+
+		if p.ExchangeRate > 0 {
+			out += fmt.Sprintf(" at %g", p.ExchangeRate)
+		}
+	}
+
+	if p.StatusMessage != "" {
+		out += ", " + p.StatusMessage
+	}
+
+	return out
+}
+
+// FormatPayoutQuote describes a payout quote.
+func FormatPayoutQuote(q *types.PayoutQuote) string {
+	payout := money.FromMinor(q.PayoutAmount, q.PayoutCurrency)
+	holding := money.FromMinor(q.HoldingAmount, q.HoldingCurrency)
+
+	if q.HoldingCurrency == q.PayoutCurrency {
+		return fmt.Sprintf("Payout of %s is funded 1:1 from the %s balance, no FX conversion.",
+			payout.Display(), q.HoldingCurrency)
+	}
+
+	return fmt.Sprintf("Payout quote: beneficiary receives %s. Rate 1 %s = %g %s."+
+		" Estimated debit from the %s balance: %s.",
+		payout.Display(), q.HoldingCurrency, q.ExchangeRate, q.PayoutCurrency, q.HoldingCurrency, holding.Display())
+}
+
+// FormatCheckout returns a summary of a checkout and its payment attempts.
+func FormatCheckout(c *types.Checkout) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Checkout %s: status %s, payment status %s", c.ID, c.Status, c.PaymentStatus)
+	fmt.Fprintf(&b, "\nAmount: %s, paid: %s",
+		money.FromMinor(c.Amount, c.InvoiceCurrency).Display(), money.FromMinor(c.AmountPaid, c.InvoiceCurrency).Display())
+
+	if c.ReferenceID != "" {
+		b.WriteString("\nReference: " + c.ReferenceID)
+	}
+
+	switch {
+	case c.CustomerDetails.Email != "":
+		fmt.Fprintf(&b, "\nCustomer: %s <%s>", c.CustomerDetails.Name, c.CustomerDetails.Email)
+
+	case c.Customer != "":
+		b.WriteString("\nCustomer: " + c.Customer)
+	}
+
+	if c.ExpiresAt != "" {
+		b.WriteString("\nExpires at: " + c.ExpiresAt)
+	}
+
+	if c.URL != "" {
+		b.WriteString("\nPayment Link URL: " + c.URL)
+	}
+
+	if len(c.PaymentAttempts) == 0 {
+		b.WriteString("\nNo payment attempts yet.")
+		return b.String()
+	}
+
+	fmt.Fprintf(&b, "\n%d payment attempt(s):", len(c.PaymentAttempts))
+
+	for i := range c.PaymentAttempts {
+		b.WriteString("\n- " + FormatPaymentAttempt(&c.PaymentAttempts[i]))
+	}
+
+	return b.String()
+}
+
+// FormatPaymentAttempt returns a one-line summary of a payment attempt,
+// including the explanation of its decline if there is one.
+func FormatPaymentAttempt(a *types.PaymentAttempt) string {
+	out := fmt.Sprintf("%s: %s, %s", a.ID, a.Status, money.FromMinor(a.Amount, a.Currency).Display())
+
+	if method := paymentMethod(a); method != "" {
+		out += " via " + method
+	}
+
+	if a.StatusDescription != "" {
+		out += " (" + a.StatusDescription + ")"
+	}
+
+	if a.DeclineCode != "" {
+		out += ", decline code " + a.DeclineCode
+	}
+
+	if a.DeclineExplanation != "" {
+		out += ". " + a.DeclineExplanation
+	}
+
+	return out
+}
+
+// paymentMethod describes the payment method of an attempt, e.g. "card (visa ****4242, SG debit)".
+func paymentMethod(a *types.PaymentAttempt) string {
+	d := a.PaymentMethodDetails
+	if d == nil {
+		return a.PaymentMethodType
+	}
+
+	method := cmp.Or(d.Type, a.PaymentMethodType)
+
+	switch {
+	case d.Card != nil:
+		card := fmt.Sprintf("%s ****%s", d.Card.Brand, d.Card.Last4)
+		if origin := strings.TrimSpace(d.Card.Country + " " + d.Card.Funding); origin != "" {
+			card += ", " + origin
+		}
+
+		method += " (" + card + ")"
+
+	case d.Wallet != "":
+		method += " (" + d.Wallet + ")"
+
+	case d.Bank != "":
+		method += " (" + d.Bank + ")"
+	}
+
+	return method
+}
+
+// ExplainDeclines fills in the decline explanation of every attempt with a decline code.
+func ExplainDeclines(attempts []types.PaymentAttempt) {
+	for i := range attempts {
+		if attempts[i].DeclineCode != "" {
+			attempts[i].DeclineExplanation = decline.Explain(attempts[i].DeclineCode).Text()
+		}
+	}
+}
+
+// FormatPayin returns a summary of a payin.
+func FormatPayin(p *types.Payin) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Payin %s: status %s", p.ID, p.Status)
+	fmt.Fprintf(&b, "\nAmount: %s, paid: %s",
+		money.FromMinor(p.Amount, p.InvoiceCurrency).Display(), money.FromMinor(p.AmountPaid, p.InvoiceCurrency).Display())
+
+	if p.ReferenceID != "" {
+		b.WriteString("\nReference: " + p.ReferenceID)
+	}
+
+	switch {
+	case p.CustomerDetails.Email != "":
+		fmt.Fprintf(&b, "\nCustomer: %s <%s>", p.CustomerDetails.Name, p.CustomerDetails.Email)
+
+	case p.Customer != "":
+		b.WriteString("\nCustomer: " + p.Customer)
+	}
+
+	if p.LatestPaymentAttempt != "" {
+		b.WriteString("\nLatest payment attempt: " + p.LatestPaymentAttempt)
+	}
+
+	if p.CreatedAt != "" {
+		b.WriteString("\nCreated at: " + p.CreatedAt)
+	}
+
+	return b.String()
+}
+
+// FormatCheckoutLine returns a one-line summary of a checkout for lists.
+func FormatCheckoutLine(c *types.Checkout) string {
+	out := fmt.Sprintf("Checkout %s: %s, payment %s, %s (paid %s)", c.ID, c.Status, c.PaymentStatus,
+		money.FromMinor(c.Amount, c.InvoiceCurrency).Display(), money.FromMinor(c.AmountPaid, c.InvoiceCurrency).Display())
+
+	if c.ReferenceID != "" {
+		out += ", reference " + c.ReferenceID
+	}
+
+	if c.ExpiresAt != "" {
+		out += ", expires " + c.ExpiresAt
+	}
+
+	return out
+}
+
+// StructuredResult returns a tool result that carries data as structured
+// content, plus the human-readable summary tagged with the environment.
+func StructuredResult(env types.Environment, data any, text string) *mcp.CallToolResult {
+	return mcp.NewToolResultStructured(data, TagEnvironment(env, text))
+}
+
+// FilterBalances returns the balances for currency, or all balances when currency is empty.
+func FilterBalances(data *types.BalanceDataBlock, currency string) *types.BalanceDataBlock {
+	if currency == "" {
+		return data
+	}
+
+	out := *data
+	out.Available = []types.Balance{}
+
+	for _, balance := range data.Available {
+		if strings.EqualFold(balance.Currency, currency) {
+			out.Available = append(out.Available, balance)
+		}
+	}
+
+	return &out
+}
+
+// FormatFXQuote describes a quote with full-precision rates
+func FormatFXQuote(q *types.FXQuote) string {
+	out := fmt.Sprintf("%s = %s\nRate: 1 %s = %s %s\nInverse rate: 1 %s = %s %s\nQuoted at %s",
+		money.FromMinor(q.SourceAmount, q.From).Display(), money.FromMinor(q.DestinationAmount, q.To).Display(),
+		q.From, strconv.FormatFloat(q.ExchangeRate, 'f', -1, 64), q.To,
+		q.To, strconv.FormatFloat(q.InverseRate, 'g', constants.FXInverseRateDigits, 64), q.From,
+		q.QuotedAt)
+
+	if q.QuoteID != "" {
+		out += ", quote ID " + q.QuoteID
+	}
+
+	return out
+}
@@ -0,0 +1,75 @@
+package validation
+
+// currencyNames maps common currency names and symbols to ISO 4217 codes.
+// Keys are normalized with normalizeName.
+var currencyNames = map[string]string{
+	"us dollar": "USD", "us dollars": "USD", "usd dollar": "USD", "american dollar": "USD", "us$": "USD",
+	"singapore dollar": "SGD", "singapore dollars": "SGD", "s$": "SGD",
+	"australian dollar": "AUD", "australian dollars": "AUD", "a$": "AUD",
+	"canadian dollar": "CAD", "canadian dollars": "CAD", "c$": "CAD",
+	"hong kong dollar": "HKD", "hong kong dollars": "HKD", "hk$": "HKD",
+	"new zealand dollar": "NZD", "new zealand dollars": "NZD",
+	"taiwan dollar": "TWD", "new taiwan dollar": "TWD",
+	"euro": "EUR", "euros": "EUR", "€": "EUR",
+	"pound sterling": "GBP", "sterling": "GBP", "british pound": "GBP", "british pounds": "GBP", "£": "GBP",
+	"yen": "JPY", "japanese yen": "JPY",
+	"renminbi": "CNY", "rmb": "CNY", "chinese yuan": "CNY",
+	"korean won": "KRW", "south korean won": "KRW", "₩": "KRW",
+	"indian rupee": "INR", "indian rupees": "INR", "₹": "INR",
+	"pakistani rupee": "PKR", "sri lankan rupee": "LKR", "nepalese rupee": "NPR",
+	"rupiah": "IDR", "indonesian rupiah": "IDR",
+	"ringgit": "MYR", "malaysian ringgit": "MYR",
+	"baht": "THB", "thai baht": "THB", "฿": "THB",
+	"philippine peso": "PHP", "peso filipino": "PHP", "₱": "PHP",
+	"dong": "VND", "vietnamese dong": "VND", "₫": "VND",
+	"taka": "BDT", "bangladeshi taka": "BDT",
+	"uae dirham": "AED", "emirati dirham": "AED",
+	"saudi riyal": "SAR", "qatari riyal": "QAR", "omani rial": "OMR",
+	"kuwaiti dinar": "KWD", "bahraini dinar": "BHD", "jordanian dinar": "JOD",
+	"swiss franc": "CHF", "swiss francs": "CHF",
+	"swedish krona": "SEK", "norwegian krone": "NOK", "danish krone": "DKK",
+	"zloty": "PLN", "polish zloty": "PLN",
+	"lira": "TRY", "turkish lira": "TRY",
+	"shekel": "ILS", "israeli shekel": "ILS",
+	"rand": "ZAR", "south african rand": "ZAR",
+	"naira": "NGN", "nigerian naira": "NGN",
+	"kenyan shilling": "KES", "cedi": "GHS", "ghanaian cedi": "GHS",
+	"egyptian pound": "EGP",
+	"real":           "BRL", "reais": "BRL", "brazilian real": "BRL", "r$": "BRL",
+	"mexican peso": "MXN", "colombian peso": "COP", "chilean peso": "CLP", "argentine peso": "ARS",
+	"sol": "PEN", "peruvian sol": "PEN",
+}
+
+// ambiguousCurrencies maps names shared by several currencies to the likely candidates.
+var ambiguousCurrencies = map[string][]string{
+	"dollar":   {"USD", "SGD", "AUD", "CAD", "HKD", "NZD"},
+	"dollars":  {"USD", "SGD", "AUD", "CAD", "HKD", "NZD"},
+	"$":        {"USD", "SGD", "AUD", "CAD", "HKD", "NZD"},
+	"peso":     {"PHP", "MXN", "COP", "CLP", "ARS"},
+	"pesos":    {"PHP", "MXN", "COP", "CLP", "ARS"},
+	"¥":        {"JPY", "CNY"},
+	"riyal":    {"SAR", "QAR"},
+	"dinar":    {"KWD", "BHD", "JOD"},
+	"krona":    {"SEK", "ISK"},
+	"krone":    {"NOK", "DKK"},
+	"franc":    {"CHF", "XOF", "XAF"},
+	"pound":    {"GBP", "EGP"},
+	"pounds":   {"GBP", "EGP"},
+	"rupee":    {"INR", "PKR", "LKR", "NPR"},
+	"rupees":   {"INR", "PKR", "LKR", "NPR"},
+	"yuan":     {"CNY", "TWD"},
+	"won":      {"KRW", "KPW"},
+	"dirham":   {"AED", "MAD"},
+	"shilling": {"KES", "UGX", "TZS"},
+}
+
+// countryCurrencies maps countries to their currency, to suggest a code when
+// a country name is given as a currency.
+var countryCurrencies = map[string]string{
+	"AE": "AED", "AU": "AUD", "BD": "BDT", "BR": "BRL", "CA": "CAD", "CH": "CHF", "CN": "CNY", "EG": "EGP",
+	"GB": "GBP", "HK": "HKD", "ID": "IDR", "IN": "INR", "JP": "JPY", "KE": "KES", "KR": "KRW", "LK": "LKR",
+	"MX": "MXN", "MY": "MYR", "NG": "NGN", "NZ": "NZD", "PH": "PHP", "PK": "PKR", "SA": "SAR", "SG": "SGD",
+	"TH": "THB", "TR": "TRY", "TW": "TWD", "US": "USD", "VN": "VND", "ZA": "ZAR",
+	"DE": "EUR", "FR": "EUR", "ES": "EUR", "IT": "EUR", "NL": "EUR", "IE": "EUR", "PT": "EUR", "BE": "EUR",
+	"AT": "EUR", "FI": "EUR",
+}
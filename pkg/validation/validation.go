@@ -0,0 +1,302 @@
+// Package validation checks and normalizes tool arguments before they reach
+// the Tazapay API, and reports problems per field so the model can correct them.
+package validation
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/money"
+)
+
+// FieldError describes an invalid argument.
+type FieldError struct {
+	Field       string   `json:"field"`
+	Message     string   `json:"message"`
+	Suggestions []string `json:"suggestions,omitempty"`
+}
+
+func (e FieldError) Error() string {
+	msg := e.Field + ": " + e.Message
+	if len(e.Suggestions) > 0 {
+		msg += " (did you mean " + strings.Join(e.Suggestions, ", ") + "?)"
+	}
+
+	return msg
+}
+
+// Errors is the list of invalid arguments of a tool call.
+// It matches constants.ErrInvalidArguments with errors.Is.
+type Errors []FieldError
+
+func (e Errors) Error() string {
+	parts := make([]string, len(e))
+	for i, fe := range e {
+		parts[i] = fe.Error()
+	}
+
+	return constants.ErrInvalidArguments.Error() + ": " + strings.Join(parts, "; ")
+}
+
+// Unwrap lets callers match any validation failure with errors.Is.
+func (Errors) Unwrap() error {
+	return constants.ErrInvalidArguments
+}
+
+// Validator collects field errors, so every invalid argument is reported at once.
+type Validator struct {
+	errs Errors
+}
+
+// New returns an empty Validator.
+func New() *Validator {
+	return &Validator{}
+}
+
+// Add records an error for field.
+func (v *Validator) Add(field, message string, suggestions ...string) {
+	v.errs = append(v.errs, FieldError{Field: field, Message: message, Suggestions: suggestions})
+}
+
+// Check records err, if any, as an error for field.
+func (v *Validator) Check(field string, err error) {
+	if err != nil {
+		v.Add(field, strings.TrimPrefix(err.Error(), field+": "))
+	}
+}
+
+// Valid reports whether no errors were recorded for field.
+func (v *Validator) Valid(field string) bool {
+	for _, fe := range v.errs {
+		if fe.Field == field {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Err returns the collected errors, or nil if all arguments are valid.
+func (v *Validator) Err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+
+	return v.errs
+}
+
+// Required records an error if value is empty.
+func (v *Validator) Required(field, value string) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		v.Add(field, "is required")
+	}
+
+	return value
+}
+
+// Currency normalizes a currency code or name to its ISO 4217 code.
+func (v *Validator) Currency(field, value string) string {
+	code, suggestions, ok := NormalizeCurrency(value)
+	if !ok {
+		v.Add(field, fmt.Sprintf("%q is not an ISO 4217 currency code; use a 3 letter code such as USD", value),
+			suggestions...)
+	}
+
+	return code
+}
+
+// OptionalCurrency is like Currency but accepts an empty value.
+func (v *Validator) OptionalCurrency(field, value string) string {
+	if strings.TrimSpace(value) == "" {
+		return ""
+	}
+
+	return v.Currency(field, value)
+}
+
+// Country normalizes a country code or name to its ISO 3166-1 alpha-2 code.
+func (v *Validator) Country(field, value string) string {
+	code, suggestions, ok := NormalizeCountry(value)
+	if !ok {
+		v.Add(field, fmt.Sprintf("%q is not an ISO 3166 country; use a 2 letter code such as SG", value),
+			suggestions...)
+	}
+
+	return code
+}
+
+// OptionalCountry is like Country but accepts an empty value.
+func (v *Validator) OptionalCountry(field, value string) string {
+	if strings.TrimSpace(value) == "" {
+		return ""
+	}
+
+	return v.Country(field, value)
+}
+
+// Email checks the syntax of a single bare email address.
+func (v *Validator) Email(field, value string) string {
+	value = strings.TrimSpace(value)
+	if !IsEmail(value) {
+		v.Add(field, fmt.Sprintf("%q is not a valid email address", value))
+	}
+
+	return value
+}
+
+// OptionalEmail is like Email but accepts an empty value.
+func (v *Validator) OptionalEmail(field, value string) string {
+	if strings.TrimSpace(value) == "" {
+		return ""
+	}
+
+	return v.Email(field, value)
+}
+
+// OptionalURL checks that value, if set, is an absolute http or https URL.
+func (v *Validator) OptionalURL(field, value string) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return ""
+	}
+
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		v.Add(field, fmt.Sprintf("%q is not an absolute http(s) URL", value))
+	}
+
+	return value
+}
+
+// OptionalFutureTime checks that value, if set, is an RFC 3339 time after now,
+// and returns it normalized to UTC.
+func (v *Validator) OptionalFutureTime(field, value string, now time.Time) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return ""
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		v.Add(field, fmt.Sprintf("%q is not an RFC 3339 time such as 2025-12-31T23:59:59Z", value))
+		return value
+	}
+
+	if !t.After(now) {
+		v.Add(field, "must be in the future")
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+// OptionalTime parses an optional RFC 3339 time; the zero time means absent.
+func (v *Validator) OptionalTime(field, value string) time.Time {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		v.Add(field, fmt.Sprintf("%q is not an RFC 3339 time such as 2025-12-31T23:59:59Z", value))
+	}
+
+	return t
+}
+
+// Amount checks that m is positive and within constants.MaxAmountMinorUnits.
+func (v *Validator) Amount(field string, m money.Money) {
+	switch {
+	case !m.IsPositive():
+		v.Add(field, "must be greater than zero")
+
+	case m.Minor > constants.MaxAmountMinorUnits:
+		v.Add(field, fmt.Sprintf("%s exceeds the maximum of %s", m.Display(),
+			money.FromMinor(constants.MaxAmountMinorUnits, m.Currency)))
+	}
+}
+
+// NormalizeCurrency returns the ISO 4217 code for a code or common name.
+// When the value is not recognized it returns candidate codes, if any.
+func NormalizeCurrency(value string) (string, []string, bool) {
+	code := strings.ToUpper(strings.TrimSpace(value))
+	if len(code) == constants.Num3 && money.IsKnown(code) {
+		return code, nil, true
+	}
+
+	key := normalizeName(value)
+	if code, ok := currencyNames[key]; ok {
+		return code, nil, true
+	}
+
+	if candidates, ok := ambiguousCurrencies[key]; ok {
+		return "", candidates, false
+	}
+
+	// A country is not a currency, but its currency is a useful suggestion
+	if cc, _, ok := NormalizeCountry(value); ok {
+		if cur, ok := countryCurrencies[cc]; ok {
+			return "", []string{cur}, false
+		}
+	}
+
+	return "", nil, false
+}
+
+// NormalizeCountry returns the ISO 3166-1 alpha-2 code for a code, alpha-3
+// code or English name. When the value is not recognized it returns candidate
+// codes whose name starts with the value, if any.
+func NormalizeCountry(value string) (string, []string, bool) {
+	code := strings.ToUpper(strings.TrimSpace(value))
+	if _, ok := countries[code]; ok && len(code) == constants.Num2 {
+		return code, nil, true
+	}
+
+	key := normalizeName(value)
+	if code, ok := countryAliases[key]; ok {
+		return code, nil, true
+	}
+
+	var candidates []string
+
+	for code, name := range countries {
+		n := normalizeName(name)
+		if n == key {
+			return code, nil, true
+		}
+
+		if key != "" && (strings.HasPrefix(n, key) || strings.HasPrefix(key, n)) {
+			candidates = append(candidates, code)
+		}
+	}
+
+	sort.Strings(candidates)
+
+	return "", candidates, false
+}
+
+// IsEmail reports whether value is a single bare address such as "a@b.co".
+func IsEmail(value string) bool {
+	addr, err := mail.ParseAddress(value)
+	if err != nil || addr.Address != value || addr.Name != "" {
+		return false
+	}
+
+	_, domain, _ := strings.Cut(value, "@")
+
+	return strings.Contains(domain, ".") && !strings.HasSuffix(domain, ".") && !strings.HasPrefix(domain, ".")
+}
+
+// normalizeName lower-cases a name and collapses punctuation and spaces.
+func normalizeName(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	s = strings.NewReplacer(".", "", ",", "", "'", "", "-", " ", "_", " ").Replace(s)
+
+	return strings.Join(strings.Fields(s), " ")
+}
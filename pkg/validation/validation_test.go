@@ -0,0 +1,98 @@
+package validation_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/money"
+	"github.com/tazapay/tazapay-mcp-server/pkg/validation"
+)
+
+func TestNormalizeCountry(t *testing.T) {
+	for in, want := range map[string]string{"Singapore": "SG", "sg": "SG", "SGP": "SG", " united states ": "US"} {
+		if got, _, ok := validation.NormalizeCountry(in); !ok || got != want {
+			t.Errorf("NormalizeCountry(%q) = %q, %v; want %q", in, got, ok, want)
+		}
+	}
+
+	if _, _, ok := validation.NormalizeCountry("Atlantis"); ok {
+		t.Error("expected Atlantis to be rejected")
+	}
+}
+
+func TestNormalizeCurrency(t *testing.T) {
+	for in, want := range map[string]string{"usd": "USD", "Singapore Dollar": "SGD", "indian rupees": "INR", "JPY": "JPY"} {
+		if got, _, ok := validation.NormalizeCurrency(in); !ok || got != want {
+			t.Errorf("NormalizeCurrency(%q) = %q, %v; want %q", in, got, ok, want)
+		}
+	}
+
+	for _, name := range []string{"dollars", "pounds", "rupee", "yuan", "won"} {
+		if _, suggestions, ok := validation.NormalizeCurrency(name); ok || len(suggestions) < 2 {
+			t.Errorf("expected %s to be ambiguous with suggestions, got: %v, %v", name, suggestions, ok)
+		}
+	}
+
+	if _, suggestions, _ := validation.NormalizeCurrency("Singapore"); len(suggestions) != 1 || suggestions[0] != "SGD" {
+		t.Errorf("expected a country to suggest its currency, got: %v", suggestions)
+	}
+}
+
+func TestValidatorCollectsFieldErrors(t *testing.T) {
+	v := validation.New()
+
+	v.Currency("invoice_currency", "dollars")
+	v.Email("customer_email", "jane@example")
+	v.Amount("payment_amount", money.FromMinor(0, "USD"))
+
+	if v.Email("ok_email", "Jane <jane@example.com>"); v.Valid("ok_email") {
+		t.Error("expected a display name to be rejected")
+	}
+
+	err := v.Err()
+	if !errors.Is(err, constants.ErrInvalidArguments) {
+		t.Fatalf("expected ErrInvalidArguments, got: %v", err)
+	}
+
+	var fields validation.Errors
+	if !errors.As(err, &fields) || len(fields) != 4 || fields[0].Field != "invoice_currency" || len(fields[0].Suggestions) == 0 {
+		t.Errorf("unexpected field errors: %+v", fields)
+	}
+}
+
+func TestAmountBounds(t *testing.T) {
+	v := validation.New()
+
+	v.Amount("ok", money.FromMinor(1050, "USD"))
+	v.Amount("big", money.FromMinor(constants.MaxAmountMinorUnits+1, "USD"))
+
+	if !v.Valid("ok") || v.Valid("big") {
+		t.Errorf("unexpected result: %v", v.Err())
+	}
+}
+
+func TestOptionalURLAndFutureTime(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	v := validation.New()
+
+	v.OptionalURL("success_url", "https://shop.example/thanks")
+	v.OptionalURL("cancel_url", "shop.example/cancel")
+	v.OptionalURL("webhook_url", "")
+
+	if got := v.OptionalFutureTime("expires_at", "2025-06-02T08:00:00+08:00", now); got != "2025-06-02T00:00:00Z" {
+		t.Errorf("expected time normalized to UTC, got: %s", got)
+	}
+
+	v.OptionalFutureTime("past", "2025-05-31T00:00:00Z", now)
+	v.OptionalFutureTime("bad", "tomorrow", now)
+
+	for field, valid := range map[string]bool{
+		"success_url": true, "cancel_url": false, "webhook_url": true, "expires_at": true, "past": false, "bad": false,
+	} {
+		if v.Valid(field) != valid {
+			t.Errorf("expected %s valid=%v, errors: %v", field, valid, v.Err())
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/store"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// Config configures a Handler.
+type Config struct {
+	Secret    []byte
+	Scheme    Scheme
+	Tolerance time.Duration // Maximum age of a delivery's timestamp
+
+	// Environment is the Tazapay environment the deliveries come from; every
+	// event is stored with it so sandbox and production events stay apart
+	Environment string
+}
+
+// Handler receives webhook deliveries.
+type Handler struct {
+	cfg     Config
+	events  store.EventStore
+	onEvent func(types.Event)
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+// NewHandler returns a handler that verifies deliveries as cfg describes,
+// stores them in events and passes every new event to onEvent, which may be nil.
+func NewHandler(cfg Config, events store.EventStore, onEvent func(types.Event), logger *slog.Logger) *Handler {
+	return &Handler{
+		cfg:     cfg,
+		events:  events,
+		onEvent: onEvent,
+		logger:  logger,
+		now:     time.Now,
+	}
+}
+
+// payload is the body of a webhook delivery.
+type payload struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt string          `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// ServeHTTP verifies and records a delivery. Duplicates are acknowledged
+// without being reported again, so Tazapay stops retrying them.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, constants.MaxWebhookBodyBytes))
+	if err != nil {
+		http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	scheme := h.cfg.Scheme
+
+	err = scheme.Verify(h.cfg.Secret, r.Header.Get(scheme.TimestampHeader), r.Header.Get(scheme.SignatureHeader), body,
+		h.now(), h.cfg.Tolerance)
+	if err != nil {
+		h.logger.Warn("webhook rejected", slog.String("remote_addr", r.RemoteAddr), slog.String("error", err.Error()))
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+
+		return
+	}
+
+	event, err := parse(body)
+	if err != nil {
+		h.logger.Warn("webhook rejected", slog.String("error", err.Error()))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	event.Environment = h.cfg.Environment
+	event.ReceivedAt = h.now().UTC()
+
+	added, err := h.events.AppendEvent(r.Context(), event)
+	if err != nil {
+		// Tazapay retries the delivery, so the event is not lost
+		h.logger.Error("failed to store webhook", slog.String("event_id", event.ID), slog.String("error", err.Error()))
+		http.Error(w, "failed to store event", http.StatusInternalServerError)
+
+		return
+	}
+
+	if !added {
+		h.logger.Info("duplicate webhook ignored", slog.String("event_id", event.ID))
+		w.WriteHeader(http.StatusOK)
+
+		return
+	}
+
+	h.logger.Info("webhook received", slog.String("event_id", event.ID), slog.String("type", event.Type),
+		slog.String("object_id", event.ObjectID))
+
+	if h.onEvent != nil {
+		h.onEvent(event)
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// parse decodes a verified body into an event.
+func parse(body []byte) (types.Event, error) {
+	var p payload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return types.Event{}, fmt.Errorf("%w: %w", constants.ErrInvalidWebhookBody, err)
+	}
+
+	if p.ID == "" || p.Type == "" {
+		return types.Event{}, fmt.Errorf("%w: id and type are required", constants.ErrInvalidWebhookBody)
+	}
+
+	var object struct {
+		ID string `json:"id"`
+	}
+
+	// Data is usually the object the event is about; events without one are still kept
+	_ = json.Unmarshal(p.Data, &object)
+
+	return types.Event{ID: p.ID, Type: p.Type, ObjectID: object.ID, CreatedAt: p.CreatedAt, Data: p.Data}, nil
+}
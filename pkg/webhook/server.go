@@ -0,0 +1,64 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+)
+
+// Serve listens on addr and passes requests to path to h until ctx is
+// cancelled. It runs next to the MCP transport, which may be stdio.
+func Serve(ctx context.Context, addr, path string, h http.Handler, logger *slog.Logger, shutdownTimeout time.Duration,
+) error {
+	mux := http.NewServeMux()
+	mux.Handle(path, h)
+
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: constants.ReadHeaderTimeout,
+	}
+
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- srv.Serve(listener)
+	}()
+
+	logger.Info("Receiving Tazapay webhooks", slog.String("addr", listener.Addr().String()), slog.String("path", path))
+
+	select {
+	case err := <-errCh:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+
+		return fmt.Errorf("webhook server: %w", err)
+
+	case <-ctx.Done():
+	}
+
+	if shutdownTimeout <= 0 {
+		shutdownTimeout = constants.DefaultShutdownTimeout
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("webhook server shutdown failed: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+// Package webhook receives Tazapay webhooks. It verifies their signature,
+// records the events in an event store and reports each new event to a
+// callback, so MCP sessions can be notified without polling the API.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+)
+
+// Scheme describes how deliveries are signed: an HMAC-SHA256 with the
+// webhook secret, sent in SignatureHeader in Encoding. With a
+// TimestampHeader the HMAC covers timestamp + "." + body and old deliveries
+// are rejected; without one it covers the body alone.
+type Scheme struct {
+	SignatureHeader string
+	TimestampHeader string
+	Encoding        string // constants.WebhookEncodingHex or constants.WebhookEncodingBase64
+}
+
+// DefaultScheme returns the scheme used when none is configured.
+func DefaultScheme() Scheme {
+	return Scheme{
+		SignatureHeader: constants.DefaultWebhookSignatureHeader,
+		TimestampHeader: constants.DefaultWebhookTimestampHeader,
+		Encoding:        constants.WebhookEncodingHex,
+	}
+}
+
+// Validate checks that the scheme can verify deliveries.
+func (s Scheme) Validate() error {
+	if s.Encoding != constants.WebhookEncodingHex && s.Encoding != constants.WebhookEncodingBase64 {
+		return fmt.Errorf("%w: %q", constants.ErrInvalidWebhookEncoding, s.Encoding)
+	}
+
+	return nil
+}
+
+// Sign returns the signature of body sent at timestamp (Unix seconds), which
+// is ignored when the scheme has no timestamp header.
+func (s Scheme) Sign(secret []byte, timestamp string, body []byte) string {
+	return s.encode(s.mac(secret, timestamp, body))
+}
+
+// Verify checks the signature of body and, when the scheme has a timestamp
+// header, rejects timestamps further than tolerance from now. The signature
+// header may hold several comma-separated signatures while the secret is
+// being rotated; any of them may match.
+func (s Scheme) Verify(secret []byte, timestamp, signature string, body []byte, now time.Time,
+	tolerance time.Duration,
+) error {
+	timestamp = strings.TrimSpace(timestamp)
+
+	if s.TimestampHeader != "" {
+		seconds, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w: missing or malformed %s", constants.ErrInvalidSignature, s.TimestampHeader)
+		}
+
+		if age := now.Sub(time.Unix(seconds, 0)).Abs(); age > tolerance {
+			return fmt.Errorf("%w: %s old", constants.ErrStaleWebhook, age.Round(time.Second))
+		}
+	}
+
+	expected := s.mac(secret, timestamp, body)
+
+	for candidate := range strings.SplitSeq(signature, ",") {
+		got, err := s.decode(strings.TrimSpace(candidate))
+		if err == nil && hmac.Equal(got, expected) {
+			return nil
+		}
+	}
+
+	return constants.ErrInvalidSignature
+}
+
+// mac computes the HMAC the scheme signs.
+func (s Scheme) mac(secret []byte, timestamp string, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+
+	if s.TimestampHeader != "" {
+		mac.Write([]byte(timestamp))
+		mac.Write([]byte("."))
+	}
+
+	mac.Write(body)
+
+	return mac.Sum(nil)
+}
+
+// encode formats an HMAC as it is sent in the signature header.
+func (s Scheme) encode(mac []byte) string {
+	if s.Encoding == constants.WebhookEncodingBase64 {
+		return base64.StdEncoding.EncodeToString(mac)
+	}
+
+	return hex.EncodeToString(mac)
+}
+
+// decode parses one signature from the signature header.
+func (s Scheme) decode(signature string) ([]byte, error) {
+	if s.Encoding == constants.WebhookEncodingBase64 {
+		return base64.StdEncoding.DecodeString(signature)
+	}
+
+	return hex.DecodeString(signature)
+}
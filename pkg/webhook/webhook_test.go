@@ -0,0 +1,124 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/store"
+	"github.com/tazapay/tazapay-mcp-server/pkg/webhook"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+var secret = []byte("whsec_test")
+
+func TestVerify(t *testing.T) {
+	scheme := webhook.DefaultScheme()
+	now := time.Unix(1_700_000_000, 0)
+	ts := strconv.FormatInt(now.Unix(), 10)
+	body := []byte(`{"id":"evt_1"}`)
+	sig := scheme.Sign(secret, ts, body)
+
+	if err := scheme.Verify(secret, ts, sig, body, now, time.Minute); err != nil {
+		t.Errorf("expected a valid signature, got: %v", err)
+	}
+
+	if err := scheme.Verify(secret, ts, "deadbeef, "+sig, body, now, time.Minute); err != nil {
+		t.Errorf("expected any of several signatures to match, got: %v", err)
+	}
+
+	err := scheme.Verify(secret, ts, sig, []byte(`{"id":"evt_2"}`), now, time.Minute)
+	if !errors.Is(err, constants.ErrInvalidSignature) {
+		t.Errorf("expected a tampered body to be rejected, got: %v", err)
+	}
+
+	err = scheme.Verify(secret, ts, sig, body, now.Add(time.Hour), time.Minute)
+	if !errors.Is(err, constants.ErrStaleWebhook) {
+		t.Errorf("expected a replayed delivery to be rejected, got: %v", err)
+	}
+}
+
+func TestVerifyBodyOnlyScheme(t *testing.T) {
+	scheme := webhook.Scheme{SignatureHeader: "X-Signature", Encoding: constants.WebhookEncodingBase64}
+	body := []byte(`{"id":"evt_1"}`)
+	sig := scheme.Sign(secret, "", body)
+
+	// Without a timestamp header, deliveries carry no timestamp to check
+	if err := scheme.Verify(secret, "", sig, body, time.Now(), time.Minute); err != nil {
+		t.Errorf("expected a valid body-only signature, got: %v", err)
+	}
+
+	if sig == webhook.DefaultScheme().Sign(secret, "", body) {
+		t.Error("expected a base64 signature to differ from the hex one")
+	}
+
+	err := scheme.Verify(secret, "", sig, []byte(`{"id":"evt_2"}`), time.Now(), time.Minute)
+	if !errors.Is(err, constants.ErrInvalidSignature) {
+		t.Errorf("expected a tampered body to be rejected, got: %v", err)
+	}
+
+	if err := (webhook.Scheme{Encoding: "base32"}).Validate(); !errors.Is(err, constants.ErrInvalidWebhookEncoding) {
+		t.Errorf("expected an unknown encoding to be rejected, got: %v", err)
+	}
+}
+
+func TestHandlerRecordsEventsOnce(t *testing.T) {
+	events := store.NewMemory(10)
+
+	var received []types.Event
+
+	scheme := webhook.Scheme{SignatureHeader: "X-Signature", TimestampHeader: "X-Timestamp", Encoding: "hex"}
+	cfg := webhook.Config{Secret: secret, Scheme: scheme, Tolerance: time.Minute, Environment: "sandbox"}
+
+	h := webhook.NewHandler(cfg, events, func(e types.Event) { received = append(received, e) },
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	body := `{"id":"evt_1","type":"checkout.paid","created_at":"2025-01-31T09:00:00Z","data":{"id":"chk_1"}}`
+
+	deliver := func(signature string) int {
+		ts := strconv.FormatInt(time.Now().Unix(), 10)
+		if signature == "" {
+			signature = scheme.Sign(secret, ts, []byte(body))
+		}
+
+		req := httptest.NewRequest(http.MethodPost, constants.DefaultWebhookPath, strings.NewReader(body))
+		req.Header.Set(scheme.TimestampHeader, ts)
+		req.Header.Set(scheme.SignatureHeader, signature)
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		return rec.Code
+	}
+
+	if code := deliver("00"); code != http.StatusUnauthorized {
+		t.Errorf("expected 401 for a bad signature, got: %d", code)
+	}
+
+	for range 2 {
+		if code := deliver(""); code != http.StatusOK {
+			t.Errorf("expected 200, got: %d", code)
+		}
+	}
+
+	if len(received) != 1 || received[0].ObjectID != "chk_1" || received[0].Object() != "checkout" {
+		t.Errorf("expected the event to be reported once, got: %+v", received)
+	}
+
+	stored, _ := events.Events(context.Background(), store.EventQuery{Type: "checkout.", Environment: "sandbox"})
+	if len(stored) != 1 {
+		t.Errorf("expected one stored sandbox event, got: %+v", stored)
+	}
+
+	if stored, _ := events.Events(context.Background(), store.EventQuery{Environment: "production"}); len(stored) != 0 {
+		t.Errorf("expected no production events, got: %+v", stored)
+	}
+}
@@ -0,0 +1,101 @@
+// Package prompts registers MCP prompts for common payment workflows. Each
+// prompt names the tools it relies on and is only offered when all of them
+// are exposed by the tool registry.
+package prompts
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+)
+
+// argument is a prompt argument.
+type argument struct {
+	name        string
+	description string
+	required    bool
+}
+
+// prompt is a workflow prompt. render builds the instructions from the
+// arguments, which are trimmed and include every required argument.
+type prompt struct {
+	name        string
+	description string
+	arguments   []argument
+	tools       []string
+	render      func(args map[string]string) string
+}
+
+// Register adds the prompts whose tools all pass filter to the server.
+func Register(s *server.MCPServer, logger *slog.Logger, filter registry.Filter) {
+	var names []string
+
+	for _, p := range all(filter) {
+		if !available(p, filter) {
+			continue
+		}
+
+		s.AddPrompt(p.definition(), p.handle)
+		names = append(names, p.name)
+	}
+
+	logger.Info("Registered MCP prompts", slog.Any("prompts", names))
+}
+
+// available reports whether every tool the prompt relies on is exposed.
+func available(p prompt, filter registry.Filter) bool {
+	for _, name := range p.tools {
+		if !exposed(name, filter) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// exposed reports whether a tool is registered and passes filter.
+func exposed(name string, filter registry.Filter) bool {
+	e, ok := registry.Lookup(name)
+	return ok && filter.Allows(e.ToolMetadata)
+}
+
+// definition returns the MCP prompt definition.
+func (p prompt) definition() mcp.Prompt {
+	opts := []mcp.PromptOption{mcp.WithPromptDescription(p.description)}
+
+	for _, a := range p.arguments {
+		argOpts := []mcp.ArgumentOption{mcp.ArgumentDescription(a.description)}
+		if a.required {
+			argOpts = append(argOpts, mcp.RequiredArgument())
+		}
+
+		opts = append(opts, mcp.WithArgument(a.name, argOpts...))
+	}
+
+	return mcp.NewPrompt(p.name, opts...)
+}
+
+// handle checks the required arguments and renders the prompt as a user message.
+func (p prompt) handle(_ context.Context, req mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	args := make(map[string]string, len(p.arguments))
+
+	for _, a := range p.arguments {
+		value := strings.TrimSpace(req.Params.Arguments[a.name])
+		if value == "" && a.required {
+			return nil, fmt.Errorf("%w: %s", constants.ErrMissingPromptArgument, a.name)
+		}
+
+		args[a.name] = value
+	}
+
+	return mcp.NewGetPromptResult(p.description, []mcp.PromptMessage{
+		mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(p.render(args))),
+	}), nil
+}
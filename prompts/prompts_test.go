@@ -0,0 +1,116 @@
+package prompts_test
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/prompts"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	_ "github.com/tazapay/tazapay-mcp-server/tools/tazapay"
+)
+
+func newServer(t *testing.T, filter registry.Filter) *server.MCPServer {
+	t.Helper()
+
+	s := server.NewMCPServer("test", "0.0.1", server.WithPromptCapabilities(false))
+	prompts.Register(s, slog.New(slog.NewTextHandler(io.Discard, nil)), filter)
+
+	return s
+}
+
+func call(t *testing.T, s *server.MCPServer, method string, params any) mcp.JSONRPCMessage {
+	t.Helper()
+
+	raw, err := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": 1, "method": method, "params": params})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s.HandleMessage(t.Context(), raw)
+}
+
+func TestCollectPaymentReferencesTools(t *testing.T) {
+	s := newServer(t, registry.Filter{})
+
+	resp := call(t, s, "prompts/get", map[string]any{
+		"name": constants.CollectPaymentPromptName,
+		"arguments": map[string]string{
+			"customer_name": "Jane Doe", "customer_email": "jane@example.com", "amount": "10.50", "currency": "USD",
+		},
+	})
+
+	out, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	text := string(out)
+	for _, want := range []string{"10.50 USD", "Jane Doe", constants.PaymentLinkToolName, constants.GetCheckoutToolName} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected prompt to contain %q, got: %s", want, text)
+		}
+	}
+
+	missing := call(t, s, "prompts/get", map[string]any{"name": constants.CollectPaymentPromptName})
+	if _, ok := missing.(mcp.JSONRPCError); !ok {
+		t.Errorf("expected an error for missing arguments, got: %+v", missing)
+	}
+}
+
+func TestReadOnlyHidesMutatingPrompts(t *testing.T) {
+	s := newServer(t, registry.Filter{ReadOnly: true})
+
+	out, err := json.Marshal(call(t, s, "prompts/list", map[string]any{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	text := string(out)
+	if strings.Contains(text, constants.CollectPaymentPromptName) || strings.Contains(text, constants.QuotePayoutPromptName) {
+		t.Errorf("expected prompts that create objects to be hidden, got: %s", text)
+	}
+
+	if !strings.Contains(text, constants.ReconcilePaymentsPromptName) {
+		t.Errorf("expected read-only prompts to stay, got: %s", text)
+	}
+}
+
+func TestInvestigatePaymentUsesPayinToolsWhenExposed(t *testing.T) {
+	payinTools := []string{
+		constants.GetPayinToolName, constants.ListPaymentAttemptsToolName, constants.ExplainDeclineToolName,
+	}
+
+	for _, tc := range []struct {
+		name   string
+		filter registry.Filter
+		want   bool
+	}{
+		{name: "exposed", want: true},
+		{name: "disabled", filter: registry.Filter{Disabled: []string{constants.CategoryPayins}}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newServer(t, tc.filter)
+
+			out, err := json.Marshal(call(t, s, "prompts/get", map[string]any{
+				"name":      constants.InvestigatePaymentPromptName,
+				"arguments": map[string]string{"checkout_id": "chk_1"},
+			}))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, tool := range payinTools {
+				if got := strings.Contains(string(out), tool); got != tc.want {
+					t.Errorf("expected mention of %s to be %v, got: %s", tool, tc.want, out)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package prompts
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+)
+
+// all returns every workflow prompt, referring to the optional tools that
+// filter exposes.
+func all(filter registry.Filter) []prompt {
+	return []prompt{
+		collectPayment(),
+		reconcilePayments(),
+		quoteAndSendPayout(),
+		investigateFailedPayment(func(tool string) bool { return exposed(tool, filter) }),
+	}
+}
+
+// collectPayment creates a payment link and tracks it.
+func collectPayment() prompt {
+	return prompt{
+		name:        constants.CollectPaymentPromptName,
+		description: constants.CollectPaymentPromptDesc,
+		arguments: []argument{
+			{constants.PromptCustomerNameArg, constants.PromptCustomerNameDesc, true},
+			{constants.PromptCustomerEmailArg, constants.PromptCustomerEmailDesc, true},
+			{constants.PromptAmountArg, constants.PromptAmountDesc, true},
+			{constants.PromptCurrencyArg, constants.PromptCurrencyDesc, true},
+			{constants.PromptDescriptionArg, constants.PromptDescriptionDesc, false},
+			{constants.PromptCustomerCountryArg, constants.PromptCustomerCountryDesc, false},
+		},
+		tools: []string{constants.PaymentLinkToolName, constants.GetCheckoutToolName},
+		render: func(args map[string]string) string {
+			var b strings.Builder
+
+			fmt.Fprintf(&b, "Collect a payment of %s %s from %s <%s>.\n\n",
+				args[constants.PromptAmountArg], args[constants.PromptCurrencyArg],
+				args[constants.PromptCustomerNameArg], args[constants.PromptCustomerEmailArg])
+
+			b.WriteString("1. ")
+			if country := args[constants.PromptCustomerCountryArg]; country != "" {
+				fmt.Fprintf(&b, "The customer is in %s. ", country)
+			} else {
+				b.WriteString("Ask me which country the customer is in. ")
+			}
+
+			if desc := args[constants.PromptDescriptionArg]; desc != "" {
+				fmt.Fprintf(&b, "Use %q as the transaction description.\n", desc)
+			} else {
+				b.WriteString("Ask me what the payment is for and use it as the transaction description.\n")
+			}
+
+			fmt.Fprintf(&b, "2. Call `%s` to create the payment link.\n", constants.PaymentLinkToolName)
+			b.WriteString("3. Give me the payment link URL and the checkout ID, and draft a short message" +
+				" I can send to the customer with the link.\n")
+			fmt.Fprintf(&b, "4. When I ask whether it was paid, call `%s` with the checkout ID"+
+				" and report the payment status and amount paid.", constants.GetCheckoutToolName)
+
+			return b.String()
+		},
+	}
+}
+
+// reconcilePayments matches a day's checkouts and refunds against balances.
+func reconcilePayments() prompt {
+	return prompt{
+		name:        constants.ReconcilePaymentsPromptName,
+		description: constants.ReconcilePaymentsPromptDesc,
+		arguments: []argument{
+			{constants.PromptDateArg, constants.PromptDateDesc, false},
+			{constants.PromptCurrencyArg, constants.PromptCurrencyFilterDesc, false},
+		},
+		tools: []string{constants.ListCheckoutsToolName, constants.ListRefundsToolName, constants.BalanceToolName},
+		render: func(args map[string]string) string {
+			var b strings.Builder
+
+			date := args[constants.PromptDateArg]
+			if date == "" {
+				date = time.Now().UTC().Format(time.DateOnly)
+			}
+
+			scope := "all currencies"
+			if currency := args[constants.PromptCurrencyArg]; currency != "" {
+				scope = currency + " only"
+			}
+
+			fmt.Fprintf(&b, "Reconcile the Tazapay payments of %s (UTC), for %s.\n\n", date, scope)
+			fmt.Fprintf(&b, "1. Call `%s`, following the cursor until you have every checkout created on %s.\n",
+				constants.ListCheckoutsToolName, date)
+			fmt.Fprintf(&b, "2. Call `%s` the same way for the refunds of that day.\n", constants.ListRefundsToolName)
+			fmt.Fprintf(&b, "3. Call `%s` for the current balances.\n", constants.BalanceToolName)
+			b.WriteString("4. Report, per currency: the number and total of paid checkouts, checkouts still" +
+				" unpaid or expired, and the number and total of refunds.\n")
+			b.WriteString("5. List anything that needs attention, such as partially paid checkouts or failed refunds," +
+				" with their IDs and reference IDs.\n\n")
+			b.WriteString("Amounts in tool results are in minor units; convert them to major units in the report.")
+
+			return b.String()
+		},
+	}
+}
+
+// quoteAndSendPayout quotes a payout and sends it once the quote is confirmed.
+func quoteAndSendPayout() prompt {
+	return prompt{
+		name:        constants.QuotePayoutPromptName,
+		description: constants.QuotePayoutPromptDesc,
+		arguments: []argument{
+			{constants.PromptBeneficiaryArg, constants.PromptBeneficiaryDesc, true},
+			{constants.PromptAmountArg, constants.PromptAmountDesc, true},
+			{constants.PromptCurrencyArg, constants.PromptCurrencyDesc, true},
+			{constants.PromptHoldingCurrencyArg, constants.PromptHoldingCurrencyDesc, false},
+			{constants.PromptPurposeArg, constants.PromptPurposeDesc, false},
+		},
+		tools: []string{
+			constants.ListBeneficiariesToolName, constants.GetBeneficiaryToolName,
+			constants.QuotePayoutToolName, constants.CreatePayoutToolName,
+		},
+		render: func(args map[string]string) string {
+			var b strings.Builder
+
+			amount, currency := args[constants.PromptAmountArg], args[constants.PromptCurrencyArg]
+
+			holding := args[constants.PromptHoldingCurrencyArg]
+			if holding == "" {
+				holding = currency
+			}
+
+			fmt.Fprintf(&b, "Send a payout of %s %s to %s, funded from the %s balance.\n\n",
+				amount, currency, args[constants.PromptBeneficiaryArg], holding)
+			fmt.Fprintf(&b, "1. Find the beneficiary: if %q is not a beneficiary ID, call `%s` and pick the match;"+
+				" ask me if there is more than one. Call `%s` and show me the destination.\n",
+				args[constants.PromptBeneficiaryArg], constants.ListBeneficiariesToolName, constants.GetBeneficiaryToolName)
+			fmt.Fprintf(&b, "2. Call `%s` and show me the FX rate and the amount that will be debited from the %s balance.\n",
+				constants.QuotePayoutToolName, holding)
+
+			b.WriteString("3. Wait for me to accept the quote. ")
+			if purpose := args[constants.PromptPurposeArg]; purpose != "" {
+				fmt.Fprintf(&b, "The purpose is %q.\n", purpose)
+			} else {
+				b.WriteString("Ask me for the purpose of the payout.\n")
+			}
+
+			fmt.Fprintf(&b, "4. Call `%s`. If it returns a preview to confirm, show it to me and only continue"+
+				" once I confirm.\n", constants.CreatePayoutToolName)
+			b.WriteString("5. Report the payout ID and status.")
+
+			return b.String()
+		},
+	}
+}
+
+// investigateFailedPayment explains why a checkout was not paid. It goes
+// through the payin and its attempts when the payin tools are exposed.
+func investigateFailedPayment(exposed func(tool string) bool) prompt {
+	payins := exposed(constants.GetPayinToolName) && exposed(constants.ListPaymentAttemptsToolName)
+	explain := exposed(constants.ExplainDeclineToolName)
+
+	return prompt{
+		name:        constants.InvestigatePaymentPromptName,
+		description: constants.InvestigatePaymentPromptDesc,
+		arguments: []argument{
+			{constants.PromptCheckoutIDArg, constants.PromptCheckoutIDDesc, false},
+			{constants.PromptReferenceIDArg, constants.PromptReferenceIDDesc, false},
+		},
+		tools: []string{constants.GetCheckoutToolName},
+		render: func(args map[string]string) string {
+			var b strings.Builder
+
+			switch id, ref := args[constants.PromptCheckoutIDArg], args[constants.PromptReferenceIDArg]; {
+			case id != "":
+				fmt.Fprintf(&b, "Investigate why the payment for checkout %s failed.\n\n", id)
+				fmt.Fprintf(&b, "1. Call `%s` with checkout_id %q.\n", constants.GetCheckoutToolName, id)
+
+			case ref != "":
+				fmt.Fprintf(&b, "Investigate why the payment with reference %s failed.\n\n", ref)
+				fmt.Fprintf(&b, "1. Call `%s` with reference_id %q; if several checkouts match, look at the latest.\n",
+					constants.GetCheckoutToolName, ref)
+
+			default:
+				b.WriteString("Investigate why a customer's payment failed.\n\n")
+				fmt.Fprintf(&b, "1. Ask me for the checkout ID or the reference ID, then call `%s`.\n",
+					constants.GetCheckoutToolName)
+			}
+
+			step := 2
+
+			if payins {
+				fmt.Fprintf(&b, "%d. Take the payin ID from the checkout's payin field, call `%s` for its status,"+
+					" and call `%s` to get every payment attempt.\n", step, constants.GetPayinToolName,
+					constants.ListPaymentAttemptsToolName)
+				step++
+			}
+
+			fmt.Fprintf(&b, "%d. Go through the payment attempts in order and note the payment method and the"+
+				" reason each one failed", step)
+			step++
+
+			if explain {
+				fmt.Fprintf(&b, "; for a declined attempt without an explanation, call `%s` with its decline code.\n",
+					constants.ExplainDeclineToolName)
+			} else {
+				b.WriteString("; declined attempts include an explanation of their decline code.\n")
+			}
+
+			fmt.Fprintf(&b, "%d. Explain in plain words what went wrong and whether the customer should retry,"+
+				" use another payment method, or contact their bank.\n", step)
+			fmt.Fprintf(&b, "%d. If the checkout has expired, say so and offer to create a new payment link.", step+1)
+
+			return b.String()
+		},
+	}
+}
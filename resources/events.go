@@ -0,0 +1,34 @@
+package resources
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// Objects whose events move money and so change balances
+var balanceObjects = []string{"checkout", "payin", "refund", "payout"}
+
+// AffectedBy returns a matcher for the resource URIs that event e may have
+// changed, to pass to Watcher.Refresh.
+func AffectedBy(e types.Event) func(uri string) bool {
+	object := e.Object()
+
+	return func(uri string) bool {
+		switch {
+		case uri == constants.EventsResourceURI:
+			return true
+
+		case object == "checkout" && (uri == constants.RecentCheckoutsResourceURI ||
+			uri == constants.RecentCheckoutsResourceURI+"/"+e.ObjectID):
+			return true
+
+		case uri == constants.BalanceResourceURI || strings.HasPrefix(uri, constants.BalanceResourceURI+"/"):
+			return slices.Contains(balanceObjects, object)
+		}
+
+		return false
+	}
+}
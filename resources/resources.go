@@ -0,0 +1,280 @@
+// Package resources exposes Tazapay account data as MCP resources that
+// clients can attach as context: balances, checkouts, FX rates and received
+// webhook events. They are backed by the same API calls as the corresponding
+// tools.
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/auth"
+	"github.com/tazapay/tazapay-mcp-server/pkg/money"
+	"github.com/tazapay/tazapay-mcp-server/pkg/store"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/pkg/validation"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// Reader fetches the current value of a resource from its template arguments.
+type Reader func(ctx context.Context, args map[string]string) (any, error)
+
+// Resources reads Tazapay resources and reports reads to a Watcher.
+type Resources struct {
+	logger  *slog.Logger
+	client  *api.Client
+	watcher *Watcher
+	events  store.EventStore
+	rates   *rateCache
+}
+
+// New returns the resources backed by client. watcher may be nil, in which
+// case no change notifications are sent, and events may be nil when webhooks
+// are not received, in which case the events resource is not offered.
+func New(logger *slog.Logger, client *api.Client, watcher *Watcher, events store.EventStore) *Resources {
+	return &Resources{
+		logger:  logger,
+		client:  client,
+		watcher: watcher,
+		events:  events,
+		rates:   &rateCache{ttl: watcher.Interval() / 2, rates: map[string]cachedRate{}},
+	}
+}
+
+// Register adds the resources and resource templates to the server.
+func (r *Resources) Register(s *server.MCPServer) {
+	s.AddResource(
+		mcp.NewResource(constants.BalanceResourceURI, constants.BalanceResourceName,
+			mcp.WithResourceDescription(constants.BalanceResourceDesc),
+			mcp.WithMIMEType(constants.ResourceMIMEType)),
+		r.handler(r.balance),
+	)
+
+	s.AddResource(
+		mcp.NewResource(constants.RecentCheckoutsResourceURI, constants.RecentCheckoutsResourceName,
+			mcp.WithResourceDescription(constants.RecentCheckoutsResourceDesc),
+			mcp.WithMIMEType(constants.ResourceMIMEType)),
+		r.handler(r.recentCheckouts),
+	)
+
+	s.AddResourceTemplate(
+		mcp.NewResourceTemplate(constants.CurrencyBalanceResourceTemplate, constants.CurrencyBalanceResourceName,
+			mcp.WithTemplateDescription(constants.CurrencyBalanceResourceDesc),
+			mcp.WithTemplateMIMEType(constants.ResourceMIMEType)),
+		server.ResourceTemplateHandlerFunc(r.handler(r.balance)),
+	)
+
+	s.AddResourceTemplate(
+		mcp.NewResourceTemplate(constants.CheckoutResourceTemplate, constants.CheckoutResourceName,
+			mcp.WithTemplateDescription(constants.CheckoutResourceDesc),
+			mcp.WithTemplateMIMEType(constants.ResourceMIMEType)),
+		server.ResourceTemplateHandlerFunc(r.handler(r.checkout)),
+	)
+
+	s.AddResourceTemplate(
+		mcp.NewResourceTemplate(constants.FXResourceTemplate, constants.FXResourceName,
+			mcp.WithTemplateDescription(constants.FXResourceDesc),
+			mcp.WithTemplateMIMEType(constants.ResourceMIMEType)),
+		server.ResourceTemplateHandlerFunc(r.handler(r.fx)),
+	)
+
+	if r.events != nil {
+		s.AddResource(
+			mcp.NewResource(constants.EventsResourceURI, constants.EventsResourceName,
+				mcp.WithResourceDescription(constants.EventsResourceDesc),
+				mcp.WithMIMEType(constants.ResourceMIMEType)),
+			r.handler(r.recentEvents),
+		)
+	}
+
+	r.logger.Info("Registered MCP resources", slog.String("environment", r.client.Environment().Name))
+}
+
+// handler adapts a Reader to an MCP resource handler. The value is returned
+// as JSON. A read made by resources/subscribe also starts watching the
+// resource for the session; plain reads are not watched.
+func (r *Resources) handler(read Reader) server.ResourceHandlerFunc {
+	return func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		if p, ok := auth.PrincipalFromContext(ctx); ok && !p.HasScope(constants.ScopePaymentsRead) {
+			return nil, fmt.Errorf("%w: %s", constants.ErrInsufficientScope, constants.ScopePaymentsRead)
+		}
+
+		uri := req.Params.URI
+		args := templateArgs(req.Params.Arguments)
+
+		value, err := read(ctx, args)
+		if err != nil {
+			r.logger.Error("resource read failed", slog.String("uri", uri), slog.String("error", err.Error()))
+			return nil, err
+		}
+
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode resource %s: %w", uri, err)
+		}
+
+		if subscribing(ctx) {
+			r.watcher.Track(ctx, uri, args, read, data)
+		}
+
+		return []mcp.ResourceContents{
+			mcp.TextResourceContents{URI: uri, MIMEType: constants.ResourceMIMEType, Text: string(data)},
+		}, nil
+	}
+}
+
+// balance reads all balances, or the balance in the currency argument.
+func (r *Resources) balance(ctx context.Context, args map[string]string) (any, error) {
+	currency := ""
+
+	if raw, ok := args[constants.ResourceCurrencyArg]; ok {
+		v := validation.New()
+		if currency = v.Currency(constants.ResourceCurrencyArg, raw); v.Err() != nil {
+			return nil, v.Err()
+		}
+	}
+
+	balances, err := r.client.GetBalances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.FilterBalances(balances, currency), nil
+}
+
+// recentCheckouts reads the latest checkouts.
+func (r *Resources) recentCheckouts(ctx context.Context, _ map[string]string) (any, error) {
+	return r.client.ListCheckouts(ctx, types.ListCheckoutsParams{
+		ListParams: types.ListParams{Limit: constants.DefaultListLimit},
+	})
+}
+
+// checkout reads the checkout in the id argument.
+func (r *Resources) checkout(ctx context.Context, args map[string]string) (any, error) {
+	id := strings.TrimSpace(args[constants.ResourceIDArg])
+	if id == "" {
+		return nil, fmt.Errorf("%w: %s", constants.ErrMissingRequiredField, constants.ResourceIDArg)
+	}
+
+	return r.client.GetCheckout(ctx, id)
+}
+
+// fx quotes one unit of the from currency in the to currency.
+func (r *Resources) fx(ctx context.Context, args map[string]string) (any, error) {
+	v := validation.New()
+
+	from := v.Currency(constants.ResourceFromArg, args[constants.ResourceFromArg])
+	to := v.Currency(constants.ResourceToArg, args[constants.ResourceToArg])
+
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	pair := from + "/" + to
+	if rate, ok := r.rates.get(pair); ok {
+		return rate, nil
+	}
+
+	one, err := money.Parse("1", from)
+	if err != nil {
+		return nil, err
+	}
+
+	quote, err := r.client.QuoteFX(ctx, from, to, one, constants.FXFixedSource)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the rate is exposed; the quote ID and time would change on every read
+	rate := fxRate{From: quote.From, To: quote.To, ExchangeRate: quote.ExchangeRate, InverseRate: quote.InverseRate}
+	r.rates.put(pair, rate)
+
+	return rate, nil
+}
+
+// recentEvents reads the latest webhook events of the client's environment.
+func (r *Resources) recentEvents(ctx context.Context, _ map[string]string) (any, error) {
+	return r.events.Events(ctx, store.EventQuery{
+		Window:      store.Window{Limit: constants.EventsResourceLimit},
+		Environment: r.client.Environment().Name,
+	})
+}
+
+// fxRate is the value of an FX resource.
+type fxRate struct {
+	From         string  `json:"from"`
+	To           string  `json:"to"`
+	ExchangeRate float64 `json:"exchange_rate"` // Units of To per unit of From
+	InverseRate  float64 `json:"inverse_rate"`  // Units of From per unit of To
+}
+
+// rateCache keeps FX rates per currency pair for half the poll interval, so
+// the sessions and polls reading a pair share one API call while every poll
+// still sees a fresh rate. Nothing is kept when polling is off.
+type rateCache struct {
+	ttl time.Duration
+
+	mu    sync.Mutex
+	rates map[string]cachedRate // By "FROM/TO"
+}
+
+type cachedRate struct {
+	rate    fxRate
+	expires time.Time
+}
+
+// get returns the rate of pair if it has not expired.
+func (c *rateCache) get(pair string) (fxRate, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, ok := c.rates[pair]
+	if !ok || !time.Now().Before(cached.expires) {
+		return fxRate{}, false
+	}
+
+	return cached.rate, true
+}
+
+// put keeps the rate of pair and drops the expired ones.
+func (c *rateCache) put(pair string, rate fxRate) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	now := time.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	maps.DeleteFunc(c.rates, func(_ string, cached cachedRate) bool { return !now.Before(cached.expires) })
+	c.rates[pair] = cachedRate{rate: rate, expires: now.Add(c.ttl)}
+}
+
+// templateArgs flattens the variables matched from a URI template.
+func templateArgs(raw map[string]any) map[string]string {
+	args := make(map[string]string, len(raw))
+
+	for name, value := range raw {
+		switch v := value.(type) {
+		case string:
+			args[name] = v
+
+		case []string:
+			args[name] = strings.Join(v, ",")
+		}
+	}
+
+	return args
+}
@@ -0,0 +1,83 @@
+package resources_test
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/resources"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+var discard = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// fxAPI is a fake Tazapay API quoting the rate it holds, and counting the quotes.
+type fxAPI struct {
+	rate   atomic.Value // float64
+	quotes atomic.Int32
+}
+
+// newServer registers the resources against a fake FX API, with a watcher
+// polling every interval.
+func newServer(t *testing.T, interval time.Duration) (*server.MCPServer, *resources.Watcher, *fxAPI) {
+	t.Helper()
+
+	fx := &fxAPI{}
+	fx.rate.Store(83.12)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fx.quotes.Add(1)
+		_, _ = fmt.Fprintf(w, `{"status":"success","data":{"exchange_rate":%v}}`, fx.rate.Load())
+	}))
+	t.Cleanup(srv.Close)
+
+	env := types.Environment{Name: constants.EnvSandbox, BaseURL: srv.URL}
+	client := api.NewClient(env, "dG9rZW4=", discard, api.WithHTTPClient(srv.Client()))
+
+	s := server.NewMCPServer("test", "0.0.1", server.WithResourceCapabilities(true, true))
+	w := resources.NewWatcher(s, discard, interval)
+	resources.New(discard, client, w, nil).Register(s)
+
+	return s, w, fx
+}
+
+func TestFXRatesSharedAcrossReads(t *testing.T) {
+	s, _, fx := newServer(t, time.Hour)
+
+	for _, uri := range []string{"tazapay://fx/USD/INR", "tazapay://fx/usd/inr", "tazapay://fx/USD/INR"} {
+		msg := `{"jsonrpc":"2.0","id":1,"method":"resources/read","params":{"uri":"` + uri + `"}}`
+		s.HandleMessage(t.Context(), []byte(msg))
+	}
+
+	if n := fx.quotes.Load(); n != 1 {
+		t.Errorf("expected reads of one pair within the poll interval to share a quote, got %d quotes", n)
+	}
+}
+
+func TestReadsAreNotWatched(t *testing.T) {
+	s, w, fx := newServer(t, 0)
+	session := &testSession{id: "s1", notifications: make(chan mcp.JSONRPCNotification, 4)}
+
+	if err := s.RegisterSession(t.Context(), session); err != nil {
+		t.Fatalf("failed to register session: %v", err)
+	}
+
+	msg := `{"jsonrpc":"2.0","id":1,"method":"resources/read","params":{"uri":"tazapay://fx/USD/INR"}}`
+	s.HandleMessage(s.WithContext(t.Context(), session), []byte(msg))
+
+	w.Poll(t.Context())
+
+	if n := fx.quotes.Load(); n != 1 {
+		t.Errorf("expected a resource that was only read not to be polled, got %d quotes", n)
+	}
+}
@@ -0,0 +1,344 @@
+package resources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+)
+
+// Watcher polls resources that sessions have subscribed to and sends
+// notifications/resources/updated to those sessions when a value changes.
+// mcp-go does not route resources/subscribe requests; the transports pass
+// them to Subscribe. Plain reads are not watched, since nothing would end
+// them over stdio. A subscription ends when the session unsubscribes or ends,
+// and a resource is only polled while a session is watching it.
+type Watcher struct {
+	server   *server.MCPServer
+	logger   *slog.Logger
+	interval time.Duration
+
+	mu      sync.Mutex
+	watched map[string]*watch
+
+	queue      sync.Mutex
+	refreshing chan struct{}           // One slot per background refresh
+	pending    []func(uri string) bool // Matchers waiting for a free slot
+	refreshes  sync.WaitGroup
+}
+
+// watch is a resource with at least one subscribed session.
+type watch struct {
+	args     map[string]string
+	read     Reader
+	digest   [sha256.Size]byte
+	sessions map[string]struct{}
+}
+
+// NewWatcher returns a watcher that checks resources every interval.
+func NewWatcher(s *server.MCPServer, logger *slog.Logger, interval time.Duration) *Watcher {
+	return &Watcher{
+		server:     s,
+		logger:     logger,
+		interval:   interval,
+		watched:    map[string]*watch{},
+		refreshing: make(chan struct{}, constants.MaxWebhookRefreshes),
+	}
+}
+
+// Track records that the session in ctx subscribed to uri and saw data.
+func (w *Watcher) Track(ctx context.Context, uri string, args map[string]string, read Reader, data []byte) {
+	if w == nil {
+		return
+	}
+
+	session := server.ClientSessionFromContext(ctx)
+	if session == nil {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	wt, ok := w.watched[uri]
+	if !ok {
+		wt = &watch{args: maps.Clone(args), read: read, sessions: map[string]struct{}{}}
+		w.watched[uri] = wt
+	}
+
+	wt.digest = sha256.Sum256(data)
+	wt.sessions[session.SessionID()] = struct{}{}
+}
+
+// Interval returns how often watched resources are polled; zero when polling is off.
+func (w *Watcher) Interval() time.Duration {
+	if w == nil {
+		return 0
+	}
+
+	return w.interval
+}
+
+// Subscribe answers a resources/subscribe or resources/unsubscribe request
+// from a session. Subscribing reads the resource on behalf of the session,
+// which starts watching it, so a resource that cannot be read cannot be
+// subscribed to either.
+func (w *Watcher) Subscribe(ctx context.Context, sessionID string, message json.RawMessage) mcp.JSONRPCMessage {
+	var req struct {
+		ID     mcp.RequestId       `json:"id"`
+		Method string              `json:"method"`
+		Params mcp.SubscribeParams `json:"params"`
+	}
+
+	if err := json.Unmarshal(message, &req); err != nil || req.Params.URI == "" {
+		return mcp.NewJSONRPCError(req.ID, mcp.INVALID_PARAMS, "a resource uri is required", nil)
+	}
+
+	if req.Method == constants.MethodResourcesUnsubscribe {
+		w.unwatch(sessionID, req.Params.URI)
+		return mcp.NewJSONRPCResultResponse(req.ID, mcp.EmptyResult{})
+	}
+
+	read, err := json.Marshal(map[string]any{
+		"jsonrpc": mcp.JSONRPC_VERSION, "id": req.ID, "method": mcp.MethodResourcesRead,
+		"params": map[string]any{"uri": req.Params.URI},
+	})
+	if err != nil {
+		return mcp.NewJSONRPCError(req.ID, mcp.INTERNAL_ERROR, err.Error(), nil)
+	}
+
+	if resp, ok := w.server.HandleMessage(w.server.WithContext(ctx, subscriber(sessionID)), read).(mcp.JSONRPCError); ok {
+		return resp
+	}
+
+	return mcp.NewJSONRPCResultResponse(req.ID, mcp.EmptyResult{})
+}
+
+// unwatch stops watching uri for a session.
+func (w *Watcher) unwatch(sessionID, uri string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if wt, ok := w.watched[uri]; ok {
+		delete(wt.sessions, sessionID)
+
+		if len(wt.sessions) == 0 {
+			delete(w.watched, uri)
+		}
+	}
+}
+
+// subscriber stands in for the session that sent a subscription request
+// while its resource is read; only the ID is used.
+type subscriber string
+
+func (s subscriber) SessionID() string {
+	return string(s)
+}
+
+func (subscriber) NotificationChannel() chan<- mcp.JSONRPCNotification {
+	return nil
+}
+
+func (subscriber) Initialize() {}
+
+func (subscriber) Initialized() bool {
+	return true
+}
+
+// subscribing reports whether a resource is read on behalf of Subscribe.
+func subscribing(ctx context.Context) bool {
+	_, ok := server.ClientSessionFromContext(ctx).(subscriber)
+	return ok
+}
+
+// Forget stops watching resources for a session that ended, and stops polling
+// the resources no other session watches. It is an OnUnregisterSession hook.
+func (w *Watcher) Forget(_ context.Context, session server.ClientSession) {
+	if w == nil {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, wt := range w.watched {
+		delete(wt.sessions, session.SessionID())
+	}
+
+	maps.DeleteFunc(w.watched, func(_ string, wt *watch) bool { return len(wt.sessions) == 0 })
+}
+
+// Run polls until ctx is cancelled. A zero interval disables polling; the
+// watcher then only reacts to Refresh.
+func (w *Watcher) Run(ctx context.Context) {
+	if w.interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			w.Poll(ctx)
+		}
+	}
+}
+
+// Poll reads every watched resource once and notifies its sessions if it changed.
+func (w *Watcher) Poll(ctx context.Context) {
+	w.Refresh(ctx, func(string) bool { return true })
+}
+
+// Refresh reads the watched resources whose URI matches and notifies their
+// sessions if they changed. It is used when a webhook says something changed,
+// rather than waiting for the next poll.
+func (w *Watcher) Refresh(ctx context.Context, match func(uri string) bool) {
+	if w == nil {
+		return
+	}
+
+	w.mu.Lock()
+	snapshot := maps.Clone(w.watched)
+	w.mu.Unlock()
+
+	maps.DeleteFunc(snapshot, func(uri string, _ *watch) bool { return !match(uri) })
+
+	for uri, wt := range snapshot {
+		value, err := wt.read(ctx, wt.args)
+		if err != nil {
+			w.logger.Warn("resource poll failed", slog.String("uri", uri), slog.String("error", err.Error()))
+			continue
+		}
+
+		data, err := json.Marshal(value)
+		if err != nil {
+			continue
+		}
+
+		if digest := sha256.Sum256(data); w.changed(uri, digest) {
+			w.notify(uri)
+		}
+	}
+}
+
+// RefreshLater runs Refresh in the background and returns at once, so a
+// webhook can be acknowledged without waiting for the API. At most
+// MaxWebhookRefreshes refreshes run at a time; while they are all busy,
+// further matchers are coalesced into one refresh that runs when a slot
+// frees up.
+func (w *Watcher) RefreshLater(ctx context.Context, match func(uri string) bool) {
+	if w == nil {
+		return
+	}
+
+	w.queue.Lock()
+	defer w.queue.Unlock()
+
+	select {
+	case w.refreshing <- struct{}{}:
+		w.refreshes.Add(1)
+		go w.refreshQueued(ctx, match)
+
+	default:
+		w.pending = append(w.pending, match)
+	}
+}
+
+// refreshQueued refreshes match, then the matchers coalesced meanwhile,
+// and frees its slot once none are left.
+func (w *Watcher) refreshQueued(ctx context.Context, match func(uri string) bool) {
+	defer w.refreshes.Done()
+
+	for {
+		w.Refresh(ctx, match)
+
+		w.queue.Lock()
+
+		if len(w.pending) == 0 || ctx.Err() != nil {
+			w.pending = nil
+			<-w.refreshing
+			w.queue.Unlock()
+
+			return
+		}
+
+		matchers := w.pending
+		w.pending = nil
+		w.queue.Unlock()
+
+		match = func(uri string) bool {
+			return slices.ContainsFunc(matchers, func(m func(string) bool) bool { return m(uri) })
+		}
+	}
+}
+
+// Wait waits for the refreshes started by RefreshLater to finish.
+func (w *Watcher) Wait() {
+	if w == nil {
+		return
+	}
+
+	w.refreshes.Wait()
+}
+
+// changed stores digest as the latest value of uri and reports whether it differs.
+func (w *Watcher) changed(uri string, digest [sha256.Size]byte) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	wt, ok := w.watched[uri]
+	if !ok || wt.digest == digest {
+		return false
+	}
+
+	wt.digest = digest
+
+	return true
+}
+
+// notify sends the update to every session watching uri, forgetting sessions that are gone.
+func (w *Watcher) notify(uri string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	wt, ok := w.watched[uri]
+	if !ok {
+		return
+	}
+
+	for id := range wt.sessions {
+		err := w.server.SendNotificationToSpecificClient(id, string(mcp.MethodNotificationResourceUpdated),
+			map[string]any{"uri": uri})
+
+		switch {
+		case errors.Is(err, server.ErrSessionNotFound):
+			delete(wt.sessions, id)
+
+		case err != nil:
+			w.logger.Warn("resource notification failed", slog.String("uri", uri),
+				slog.String("session", id), slog.String("error", err.Error()))
+		}
+	}
+
+	if len(wt.sessions) == 0 {
+		delete(w.watched, uri)
+	}
+
+	w.logger.Info("resource changed", slog.String("uri", uri), slog.Int("sessions", len(wt.sessions)))
+}
@@ -0,0 +1,205 @@
+package resources_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/resources"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+type testSession struct {
+	id            string
+	notifications chan mcp.JSONRPCNotification
+}
+
+func (*testSession) Initialize() {}
+
+func (*testSession) Initialized() bool {
+	return true
+}
+
+func (s *testSession) SessionID() string {
+	return s.id
+}
+
+func (s *testSession) NotificationChannel() chan<- mcp.JSONRPCNotification {
+	return s.notifications
+}
+
+func TestWatcherNotifiesOnChange(t *testing.T) {
+	s := server.NewMCPServer("test", "0.0.1")
+	session := &testSession{id: "s1", notifications: make(chan mcp.JSONRPCNotification, 4)}
+
+	if err := s.RegisterSession(t.Context(), session); err != nil {
+		t.Fatalf("failed to register session: %v", err)
+	}
+
+	w := resources.NewWatcher(s, slog.New(slog.NewTextHandler(io.Discard, nil)), time.Hour)
+
+	balance := "100"
+	read := func(context.Context, map[string]string) (any, error) {
+		return map[string]string{"amount": balance}, nil
+	}
+
+	w.Track(s.WithContext(t.Context(), session), "tazapay://balance", nil, read, []byte(`{"amount":"100"}`))
+
+	w.Poll(t.Context())
+
+	if len(session.notifications) != 0 {
+		t.Fatalf("expected no notification for an unchanged value")
+	}
+
+	balance = "250"
+	w.Poll(t.Context())
+
+	select {
+	case n := <-session.notifications:
+		if n.Method != string(mcp.MethodNotificationResourceUpdated) || n.Params.AdditionalFields["uri"] != "tazapay://balance" {
+			t.Errorf("unexpected notification: %+v", n)
+		}
+
+	default:
+		t.Fatal("expected a resources/updated notification")
+	}
+
+	w.Poll(t.Context())
+
+	if len(session.notifications) != 0 {
+		t.Errorf("expected a single notification per change")
+	}
+}
+
+func TestRefreshLaterCoalescesBursts(t *testing.T) {
+	s := server.NewMCPServer("test", "0.0.1")
+	session := &testSession{id: "s1", notifications: make(chan mcp.JSONRPCNotification, 4)}
+
+	if err := s.RegisterSession(t.Context(), session); err != nil {
+		t.Fatalf("failed to register session: %v", err)
+	}
+
+	w := resources.NewWatcher(s, slog.New(slog.NewTextHandler(io.Discard, nil)), time.Hour)
+
+	var reads atomic.Int32
+
+	release := make(chan struct{})
+	read := func(context.Context, map[string]string) (any, error) {
+		<-release
+		return map[string]int32{"reads": reads.Add(1)}, nil
+	}
+
+	w.Track(s.WithContext(t.Context(), session), "tazapay://balance", nil, read, nil)
+
+	// Returns while every refresh is blocked on the API
+	for range constants.MaxWebhookRefreshes + 3 {
+		w.RefreshLater(t.Context(), func(string) bool { return true })
+	}
+
+	close(release)
+	w.Wait()
+
+	if n, want := reads.Load(), int32(constants.MaxWebhookRefreshes+1); n != want {
+		t.Errorf("expected the events beyond the limit to share one refresh, got %d reads", n)
+	}
+}
+
+func TestAffectedBy(t *testing.T) {
+	paid := resources.AffectedBy(types.Event{ID: "evt_1", Type: "checkout.paid", ObjectID: "chk_1"})
+
+	for uri, want := range map[string]bool{
+		"tazapay://events":          true,
+		"tazapay://checkouts":       true,
+		"tazapay://checkouts/chk_1": true,
+		"tazapay://checkouts/chk_2": false,
+		"tazapay://balance/USD":     true,
+		"tazapay://fx/USD/INR":      false,
+	} {
+		if got := paid(uri); got != want {
+			t.Errorf("checkout.paid affects %s = %v, want %v", uri, got, want)
+		}
+	}
+
+	if resources.AffectedBy(types.Event{ID: "evt_2", Type: "customer.updated"})("tazapay://balance") {
+		t.Error("expected a customer event not to affect balances")
+	}
+}
+
+func TestWatcherForgetsEndedSessions(t *testing.T) {
+	hooks := &server.Hooks{}
+	s := server.NewMCPServer("test", "0.0.1", server.WithHooks(hooks))
+	session := &testSession{id: "s1", notifications: make(chan mcp.JSONRPCNotification, 4)}
+
+	if err := s.RegisterSession(t.Context(), session); err != nil {
+		t.Fatalf("failed to register session: %v", err)
+	}
+
+	w := resources.NewWatcher(s, slog.New(slog.NewTextHandler(io.Discard, nil)), time.Hour)
+	hooks.AddOnUnregisterSession(w.Forget)
+
+	reads := 0
+	read := func(context.Context, map[string]string) (any, error) {
+		reads++
+		return map[string]int{"reads": reads}, nil
+	}
+
+	w.Track(s.WithContext(t.Context(), session), "tazapay://checkouts/chk_1", nil, read, nil)
+
+	s.UnregisterSession(t.Context(), session.id)
+	w.Poll(t.Context())
+
+	if reads != 0 {
+		t.Errorf("expected a resource without sessions not to be polled, got %d reads", reads)
+	}
+}
+
+func TestWatcherSubscriptions(t *testing.T) {
+	// Without polling, rates are not cached and every poll sees the latest one
+	s, w, fx := newServer(t, 0)
+	session := &testSession{id: "s1", notifications: make(chan mcp.JSONRPCNotification, 4)}
+
+	if err := s.RegisterSession(t.Context(), session); err != nil {
+		t.Fatalf("failed to register session: %v", err)
+	}
+
+	request := func(method, uri string) mcp.JSONRPCMessage {
+		msg := `{"jsonrpc":"2.0","id":7,"method":"` + method + `","params":{"uri":"` + uri + `"}}`
+		return w.Subscribe(t.Context(), session.id, []byte(msg))
+	}
+
+	if resp, ok := request(constants.MethodResourcesSubscribe, "tazapay://fx/USD/INR").(mcp.JSONRPCResponse); !ok ||
+		resp.ID.Value() != int64(7) {
+		t.Fatalf("expected the subscription to succeed, got: %+v", resp)
+	}
+
+	fx.rate.Store(83.5)
+	w.Poll(t.Context())
+
+	if len(session.notifications) != 1 {
+		t.Fatalf("expected a notification for the subscribed resource, got %d", len(session.notifications))
+	}
+
+	<-session.notifications
+
+	if _, ok := request(constants.MethodResourcesUnsubscribe, "tazapay://fx/USD/INR").(mcp.JSONRPCResponse); !ok {
+		t.Fatal("expected the unsubscription to succeed")
+	}
+
+	fx.rate.Store(84.0)
+	w.Poll(t.Context())
+
+	if len(session.notifications) != 0 {
+		t.Errorf("expected no notification after unsubscribing")
+	}
+
+	if _, ok := request(constants.MethodResourcesSubscribe, "tazapay://unknown").(mcp.JSONRPCError); !ok {
+		t.Errorf("expected subscribing to an unknown resource to fail")
+	}
+}
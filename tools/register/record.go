@@ -0,0 +1,204 @@
+package registertool
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/audit"
+	"github.com/tazapay/tazapay-mcp-server/pkg/auth"
+	"github.com/tazapay/tazapay-mcp-server/pkg/confirm"
+	"github.com/tazapay/tazapay-mcp-server/pkg/store"
+	"github.com/tazapay/tazapay-mcp-server/pkg/toolerror"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// recorder keeps a record of tool calls, and of the objects created or
+// changed by tools with an object kind, in the store, and writes the calls of
+// tools that are not read-only to the audit log. Arguments are redacted and
+// only the summary fields of objects are kept, so neither holds personal
+// data. Failures are logged and never fail the call.
+type recorder struct {
+	store  store.Store // Nil when nothing is recorded
+	audit  *audit.Log  // Nil when auditing is off
+	logger *slog.Logger
+	env    types.Environment
+}
+
+// record stores a finished call. err is the classified failure, or nil when
+// the tool returned result.
+func (r recorder) record(ctx context.Context, meta types.ToolMetadata, req mcp.CallToolRequest,
+	result *mcp.CallToolResult, err *toolerror.ToolError, started time.Time,
+) {
+	if r.store == nil && r.audit == nil {
+		return
+	}
+
+	// The call is recorded even when the client has gone away
+	ctx = context.WithoutCancel(ctx)
+
+	call := types.ToolCall{
+		Tool:        meta.Name,
+		Environment: r.env.Name,
+		Arguments:   audit.Redact(meta.Name, req.GetArguments()),
+		Outcome:     constants.OutcomeOK,
+		StartedAt:   started.UTC(),
+		DurationMS:  time.Since(started).Milliseconds(),
+	}
+
+	if p, ok := auth.PrincipalFromContext(ctx); ok {
+		call.Principal = p.Subject
+	}
+
+	var (
+		content any
+		object  *types.StoredObject
+	)
+
+	if result != nil {
+		content = result.StructuredContent
+	}
+
+	switch gated, _ := content.(confirm.Result); {
+	case err != nil:
+		call.Outcome, call.ErrorCode = constants.OutcomeError, err.Code
+
+	case gated.Cancelled:
+		call.Outcome = constants.OutcomeCancelled
+
+	case gated.ConfirmationRequired:
+		call.Outcome = constants.OutcomeConfirmationRequired
+
+	case meta.Object != "":
+		if object = r.object(meta, content, call.StartedAt); object != nil {
+			call.ObjectID, object.Principal = object.ID, call.Principal
+		}
+	}
+
+	if !meta.ReadOnly {
+		r.writeAudit(ctx, call)
+	}
+
+	if r.store == nil {
+		return
+	}
+
+	if object != nil {
+		if err := r.store.SaveObject(ctx, *object); err != nil {
+			r.logger.Error("failed to store object", slog.String("tool", meta.Name), slog.String("error", err.Error()))
+		}
+	}
+
+	if err := r.store.RecordCall(ctx, call); err != nil {
+		r.logger.Error("failed to store tool call", slog.String("tool", meta.Name), slog.String("error", err.Error()))
+	}
+}
+
+// writeAudit appends a call to the audit log.
+func (r recorder) writeAudit(ctx context.Context, call types.ToolCall) {
+	if r.audit == nil {
+		return
+	}
+
+	entry := audit.Entry{
+		StartedAt:   call.StartedAt,
+		Client:      client(ctx),
+		Tool:        call.Tool,
+		Environment: call.Environment,
+		ObjectIDs:   audit.ObjectIDs(call.ObjectID, call.Arguments),
+		Outcome:     call.Outcome,
+		ErrorCode:   call.ErrorCode,
+	}
+
+	args, err := json.Marshal(call.Arguments)
+	if err == nil {
+		entry.Arguments = args
+		_, err = r.audit.Append(entry)
+	}
+
+	if err != nil {
+		r.logger.Error("failed to write audit entry", slog.String("tool", call.Tool), slog.String("error", err.Error()))
+	}
+}
+
+// client identifies the caller in ctx: the authenticated principal, if any,
+// and the MCP session with the client name it announced.
+func client(ctx context.Context) audit.Client {
+	var c audit.Client
+
+	if p, ok := auth.PrincipalFromContext(ctx); ok {
+		c.Principal, c.AuthMethod = p.Subject, p.Method
+	}
+
+	if session := server.ClientSessionFromContext(ctx); session != nil {
+		c.Session = session.SessionID()
+
+		if s, ok := session.(server.SessionWithClientInfo); ok {
+			info := s.GetClientInfo()
+			c.Name, c.Version = info.Name, info.Version
+		}
+	}
+
+	return c
+}
+
+// summaryFields are the fields of a created object that are stored. The
+// rest, such as names, emails, phone numbers and bank details, can be looked
+// up on Tazapay by ID.
+var summaryFields = []string{
+	"id", "quote_id", "type", "status", "payment_status", "url", "reference_id", "payin", "beneficiary", "customer",
+	"amount", "amount_paid", "currency", "invoice_currency", "holding_currency", "from", "to", "fixed_side",
+	"source_amount", "destination_amount", "exchange_rate", "country", "expires_at", "created_at", "quoted_at",
+}
+
+// object returns the summary of the object in the structured content of a
+// successful result, or nil when it has no ID. FX quotes are identified by
+// Tazapay's quote ID, and are not kept when the API returned none.
+func (r recorder) object(meta types.ToolMetadata, content any, at time.Time) *types.StoredObject {
+	raw, err := json.Marshal(content)
+	if err != nil {
+		return nil
+	}
+
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(raw, &fields) != nil {
+		return nil
+	}
+
+	var id string
+	for _, field := range []string{"id", "quote_id"} {
+		if json.Unmarshal(fields[field], &id) == nil && id != "" {
+			break
+		}
+	}
+
+	if id == "" {
+		return nil
+	}
+
+	maps.DeleteFunc(fields, func(field string, _ json.RawMessage) bool {
+		return !slices.Contains(summaryFields, field)
+	})
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil
+	}
+
+	return &types.StoredObject{
+		ID:          id,
+		Kind:        meta.Object,
+		Tool:        meta.Name,
+		Environment: r.env.Name,
+		Data:        data,
+		CreatedAt:   at,
+		UpdatedAt:   at,
+	}
+}
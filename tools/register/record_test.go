@@ -0,0 +1,191 @@
+package registertool_test
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/audit"
+	"github.com/tazapay/tazapay-mcp-server/pkg/store"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	registertool "github.com/tazapay/tazapay-mcp-server/tools/register"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+const customerJSON = `{"status":"success","data":{"id":"cus_1","name":"Ada Lovelace","email":"ada@example.com",` +
+	`"country":"GB","phone":{"calling_code":"44","number":"7700900123"},"reference_id":"crm-42",` +
+	`"created_at":"2025-01-31T09:00:00Z"}}`
+
+// secrets are the personal data sent to and returned by the customer API.
+var secrets = []string{"Ada Lovelace", "ada@example.com", "7700900123"}
+
+// customerAPI is a fake Tazapay API that finds no customer by email and
+// creates the customer above.
+func customerAPI(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodGet {
+		_, _ = io.WriteString(w, `{"status":"success","data":{"data":[],"has_more":false}}`)
+		return
+	}
+
+	_, _ = io.WriteString(w, customerJSON)
+}
+
+// newServer registers the create customer and FX tools against the fake Tazapay API handler.
+func newServer(t *testing.T, st store.Store, auditLog *audit.Log, handler http.HandlerFunc) *server.MCPServer {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	env := types.Environment{Name: constants.EnvSandbox, BaseURL: srv.URL}
+	client := api.NewClient(env, "dG9rZW4=", logger, api.WithHTTPClient(srv.Client()))
+
+	s := server.NewMCPServer("test", "0.0.1", server.WithToolCapabilities(false))
+	deps := registry.Deps{Logger: logger, Client: client, Store: st, Audit: auditLog}
+
+	err := registertool.RegisterTools(s, deps, nil, registry.Filter{
+		Enabled: []string{constants.CreateCustomerToolName, constants.FXToolName},
+	})
+	if err != nil {
+		t.Fatalf("failed to register tools: %v", err)
+	}
+
+	return s
+}
+
+func TestRecordRedactsPersonalData(t *testing.T) {
+	st := store.NewMemory(10)
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	auditLog, err := audit.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer auditLog.Close()
+
+	s := newServer(t, st, auditLog, customerAPI)
+
+	raw, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0", "id": 1, "method": "tools/call",
+		"params": map[string]any{
+			"name": constants.CreateCustomerToolName,
+			"arguments": map[string]any{
+				constants.CustomerNameField:      "Ada Lovelace",
+				constants.CustomerEmailField:     "ada@example.com",
+				constants.CustomerCountryField:   "GB",
+				constants.CustomerPhoneCodeField: "44",
+				constants.CustomerPhoneField:     "7700900123",
+				constants.CustomerReferenceField: "crm-42",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp, _ := json.Marshal(s.HandleMessage(t.Context(), raw)); !strings.Contains(string(resp), "cus_1") {
+		t.Fatalf("expected the customer to be created, got: %s", resp)
+	}
+
+	calls, err := st.Calls(t.Context(), store.CallQuery{})
+	if err != nil || len(calls) != 1 {
+		t.Fatalf("expected 1 recorded call, got: %v, %v", calls, err)
+	}
+
+	if calls[0].ObjectID != "cus_1" || calls[0].Outcome != constants.OutcomeOK {
+		t.Errorf("expected an ok call that created cus_1, got: %+v", calls[0])
+	}
+
+	objects, err := st.Objects(t.Context(), store.ObjectQuery{})
+	if err != nil || len(objects) != 1 {
+		t.Fatalf("expected 1 stored object, got: %v, %v", objects, err)
+	}
+
+	if objects[0].ID != "cus_1" || objects[0].Kind != constants.ObjectCustomer {
+		t.Errorf("expected customer cus_1, got: %+v", objects[0])
+	}
+
+	args, _ := json.Marshal(calls[0].Arguments)
+	entries, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, data := range map[string]string{
+		"call arguments": string(args), "object": string(objects[0].Data), "audit log": string(entries),
+	} {
+		for _, secret := range secrets {
+			if strings.Contains(data, secret) {
+				t.Errorf("expected the %s not to contain %q, got: %s", name, secret, data)
+			}
+		}
+	}
+
+	for _, want := range []string{`"id":"cus_1"`, `"reference_id":"crm-42"`} {
+		if !strings.Contains(string(objects[0].Data), want) {
+			t.Errorf("expected the object to keep %s, got: %s", want, objects[0].Data)
+		}
+	}
+}
+
+func TestRecordWithoutStore(t *testing.T) {
+	s := newServer(t, nil, nil, customerAPI)
+
+	raw := `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"` + constants.CreateCustomerToolName +
+		`","arguments":{"customer_name":"Ada Lovelace"}}}`
+
+	resp, _ := json.Marshal(s.HandleMessage(t.Context(), json.RawMessage(raw)))
+	if !strings.Contains(string(resp), `"isError":true`) {
+		t.Errorf("expected an invalid call to fail without a store, got: %s", resp)
+	}
+}
+
+func TestRecordFXQuotes(t *testing.T) {
+	st := store.NewMemory(10)
+
+	s := newServer(t, st, nil, func(w http.ResponseWriter, r *http.Request) {
+		// Tazapay only returns a quote ID for some currency pairs
+		if r.URL.Query().Get("final_currency") == "INR" {
+			_, _ = io.WriteString(w, `{"status":"success","data":{"id":"fxq_1","exchange_rate":83.12,"converted_amount":8312}}`)
+			return
+		}
+
+		_, _ = io.WriteString(w, `{"status":"success","data":{"exchange_rate":1.35,"converted_amount":135}}`)
+	})
+
+	for _, to := range []string{"INR", "SGD"} {
+		raw, err := json.Marshal(map[string]any{
+			"jsonrpc": "2.0", "id": 1, "method": "tools/call",
+			"params": map[string]any{
+				"name":      constants.FXToolName,
+				"arguments": map[string]any{constants.FXFromField: "USD", constants.FXToField: to, constants.FXAmountField: 100},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		s.HandleMessage(t.Context(), raw)
+	}
+
+	objects, err := st.Objects(t.Context(), store.ObjectQuery{})
+	if err != nil || len(objects) != 1 {
+		t.Fatalf("expected only the quote with a Tazapay quote ID to be stored, got: %v, %v", objects, err)
+	}
+
+	if objects[0].ID != "fxq_1" || objects[0].Kind != constants.ObjectFXQuote ||
+		!strings.Contains(string(objects[0].Data), `"destination_amount":831200`) {
+		t.Errorf("expected FX quote fxq_1 with its amounts, got: %+v, %s", objects[0], objects[0].Data)
+	}
+}
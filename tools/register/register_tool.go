@@ -2,38 +2,118 @@ package registertool
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 
-	"github.com/tazapay/tazapay-mcp-server/tools/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/auth"
+	"github.com/tazapay/tazapay-mcp-server/pkg/confirm"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/toolerror"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	_ "github.com/tazapay/tazapay-mcp-server/tools/tazapay" // Registers the Tazapay tools
 	"github.com/tazapay/tazapay-mcp-server/types"
 )
 
-// RegisterTools registers all tools with the server
-func RegisterTools(s *server.MCPServer, logger *slog.Logger) {
-	logger.Info("Registering tools with MCP server")
+// RegisterTools adds the registered tools that pass the filter to the server.
+// Tools listed in the confirmation gate only run after the user confirmed a preview.
+func RegisterTools(s *server.MCPServer, deps registry.Deps, gate *confirm.Gate, filter registry.Filter) error {
+	logger, env := deps.Logger, deps.Client.Environment()
 
-	tools := []types.Tool{
-		tazapay.NewFXTool(logger),
-		tazapay.NewPaymentLinkTool(logger),
-		tazapay.NewBalanceTool(logger),
+	logger.Info("Registering tools with MCP server", slog.String("environment", env.Name))
+
+	if err := filter.Validate(); err != nil {
+		return err
+	}
+
+	entries := filter.Select()
+	if len(entries) == 0 {
+		return constants.ErrNoToolsLeft
 	}
 
-	for _, tool := range tools {
-		registerTool(s, tool)
+	names := make([]string, 0, len(entries))
+	rec := recorder{store: deps.Store, audit: deps.Audit, logger: logger, env: env}
+
+	for _, e := range entries {
+		tool := e.New(deps)
+		if tool == nil {
+			logger.Info("Tool unavailable with the current configuration", slog.String("tool", e.Name))
+			continue
+		}
+
+		registerTool(s, rec, e.ToolMetadata, tool, gate)
+		names = append(names, e.Name)
 	}
+
+	logger.Info("Tools registered", slog.Any("tools", names), slog.Bool("read_only", filter.ReadOnly))
+
+	return nil
 }
 
-// registerTool registers a single tool with the server
-func registerTool(s *server.MCPServer, tool types.Tool) {
-	s.AddTool(tool.Definition(), createHandler(tool))
+// registerTool registers a single tool with the server, annotating whether it
+// changes anything so clients can treat read-only tools differently.
+func registerTool(s *server.MCPServer, rec recorder, meta types.ToolMetadata, tool types.Tool, gate *confirm.Gate) {
+	def := gate.Decorate(tool.Definition())
+	def.Annotations.ReadOnlyHint = mcp.ToBoolPtr(meta.ReadOnly)
+
+	s.AddTool(def, createHandler(rec, meta, tool, gate))
 }
 
-// createHandler creates a handler function for a tool
-func createHandler(tool types.Tool) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+// createHandler creates a handler function for a tool.
+// Gated tools return a preview until the call is confirmed. Each call carries
+// a fresh idempotency key, so an HTTP retry of the call cannot create a second
+// object on the Tazapay side while a repeated call still can. Failures are returned as
+// results with IsError set, carrying an error code and a remediation hint,
+// rather than as protocol errors the model never sees. Every call is recorded.
+func createHandler(rec recorder, meta types.ToolMetadata, tool types.Tool, gate *confirm.Gate,
+) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		return tool.Handle(ctx, req)
+		started := time.Now()
+
+		result, err := handle(ctx, meta, tool, gate, req)
+		if err != nil {
+			te := toolerror.Classify(err)
+			rec.logger.Error("tool call failed", slog.String("tool", req.Params.Name),
+				slog.String("code", te.Code), slog.String("error", err.Error()))
+			rec.record(ctx, meta, req, nil, &te, started)
+
+			return te.Result(rec.env), nil
+		}
+
+		rec.record(ctx, meta, req, result, nil, started)
+
+		return result, nil
+	}
+}
+
+// handle checks the caller's scope, then runs the confirmation gate and the tool.
+// Callers authenticated with OAuth need the tool's scope; stdio and static
+// tokens are unrestricted.
+func handle(ctx context.Context, meta types.ToolMetadata, tool types.Tool, gate *confirm.Gate,
+	req mcp.CallToolRequest,
+) (*mcp.CallToolResult, error) {
+	if p, ok := auth.PrincipalFromContext(ctx); ok && !p.HasScope(meta.Scope) {
+		return nil, fmt.Errorf("%w: %s", constants.ErrInsufficientScope, meta.Scope)
+	}
+
+	if gate.Requires(req.Params.Name) {
+		result, err := gate.Check(ctx, tool, req)
+		if result != nil || err != nil {
+			return result, err
+		}
+
+		// The token only authorizes the call; the tool never sees it
+		req.Params.Arguments = confirm.WithoutToken(req.GetArguments())
 	}
+
+	if !meta.ReadOnly {
+		ctx = api.WithIdempotencyKey(ctx, utils.NewIdempotencyKey())
+	}
+
+	return tool.Handle(ctx, req)
 }
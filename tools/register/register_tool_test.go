@@ -0,0 +1,58 @@
+package registertool_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+)
+
+func TestEachCallGetsItsOwnIdempotencyKey(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		keys []string
+	)
+
+	s := newServer(t, nil, nil, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			mu.Lock()
+			keys = append(keys, r.Header.Get(constants.HeaderIdempotencyKey))
+			mu.Unlock()
+		}
+
+		customerAPI(w, r)
+	})
+
+	call := func(email string) {
+		raw, err := json.Marshal(map[string]any{
+			"jsonrpc": "2.0", "id": 1, "method": "tools/call",
+			"params": map[string]any{
+				"name": constants.CreateCustomerToolName,
+				"arguments": map[string]any{
+					constants.CustomerNameField:    "Ada Lovelace",
+					constants.CustomerEmailField:   email,
+					constants.CustomerCountryField: "GB",
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		s.HandleMessage(t.Context(), raw)
+	}
+
+	// Two deliberate, identical calls are two customers
+	call("ada@example.com")
+	call("ada@example.com")
+
+	if len(keys) != 2 {
+		t.Fatalf("expected 2 customer creations, got: %d", len(keys))
+	}
+
+	if keys[0] == "" || keys[0] == keys[1] {
+		t.Errorf("expected identical calls to send distinct Idempotency-Keys, got: %q and %q", keys[0], keys[1])
+	}
+}
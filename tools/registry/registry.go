@@ -0,0 +1,182 @@
+// Package registry holds the tools the server can expose. Tools register
+// themselves with their metadata from an init function, and a Filter built
+// from config decides which of them are added to the MCP server.
+package registry
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/audit"
+	"github.com/tazapay/tazapay-mcp-server/pkg/auth"
+	"github.com/tazapay/tazapay-mcp-server/pkg/store"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// Deps holds what tools are built from.
+type Deps struct {
+	Logger *slog.Logger
+	Client *api.Client
+	Store  store.Store      // Records tool calls and the objects they create
+	Events store.EventStore // Nil when webhooks are not received
+	Audit  *audit.Log       // Records calls of tools that are not read-only; nil when auditing is off
+}
+
+// Factory builds a tool from deps. It returns nil when the tool cannot work
+// with deps, e.g. when a dependency it needs is not configured.
+type Factory func(deps Deps) types.Tool
+
+// Entry is a registered tool.
+type Entry struct {
+	types.ToolMetadata
+	New Factory
+}
+
+var (
+	mu      sync.Mutex
+	entries = map[string]Entry{}
+)
+
+// Register adds a tool to the registry. It panics when the name is already
+// taken, since that can only be a programming error.
+func Register(meta types.ToolMetadata, newTool Factory) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if _, ok := entries[meta.Name]; ok {
+		panic(fmt.Sprintf("%v: %s", constants.ErrDuplicateTool, meta.Name))
+	}
+
+	entries[meta.Name] = Entry{ToolMetadata: meta, New: newTool}
+}
+
+// All returns the registered tools sorted by name.
+func All() []Entry {
+	mu.Lock()
+	defer mu.Unlock()
+
+	all := make([]Entry, 0, len(entries))
+	for _, e := range entries {
+		all = append(all, e)
+	}
+
+	slices.SortFunc(all, func(a, b Entry) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return all
+}
+
+// Lookup returns the registered tool with the given name.
+func Lookup(name string) (Entry, bool) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	e, ok := entries[name]
+
+	return e, ok
+}
+
+// Scopes returns the distinct scopes required by the registered tools.
+func Scopes() []string {
+	var scopes []string
+
+	for _, e := range All() {
+		if e.Scope != "" && !slices.Contains(scopes, e.Scope) {
+			scopes = append(scopes, e.Scope)
+		}
+	}
+
+	slices.Sort(scopes)
+
+	return scopes
+}
+
+// readScopes maps a write scope to the read scope of the same objects.
+var readScopes = map[string]string{
+	constants.ScopePaymentsWrite: constants.ScopePaymentsRead,
+	constants.ScopePayoutsWrite:  constants.ScopePayoutsRead,
+}
+
+// Readable returns the names of the registered tools whose recorded calls and
+// objects p may see: those whose scope, or the read scope of the objects they
+// change, p holds. A payments:read caller thus sees payment link calls but no
+// payouts.
+func Readable(p *auth.Principal) []string {
+	readable := []string{}
+
+	for _, e := range All() {
+		if e.Scope == "" || p.HasScope(e.Scope) || (readScopes[e.Scope] != "" && p.HasScope(readScopes[e.Scope])) {
+			readable = append(readable, e.Name)
+		}
+	}
+
+	return readable
+}
+
+// Filter selects the tools to expose. Enabled and Disabled hold tool names
+// or categories; an empty Enabled list means every tool.
+type Filter struct {
+	Enabled  []string
+	Disabled []string
+	ReadOnly bool // Drop every tool that creates or changes Tazapay objects
+}
+
+// Validate rejects names that are neither a registered tool nor a category,
+// so a typo cannot silently expose more tools than intended.
+func (f Filter) Validate() error {
+	for _, name := range slices.Concat(f.Enabled, f.Disabled) {
+		if !known(name) {
+			return fmt.Errorf("%w: %q", constants.ErrUnknownTool, name)
+		}
+	}
+
+	return nil
+}
+
+// Allows reports whether a tool passes the filter.
+func (f Filter) Allows(meta types.ToolMetadata) bool {
+	if f.ReadOnly && !meta.ReadOnly {
+		return false
+	}
+
+	if len(f.Enabled) > 0 && !matches(f.Enabled, meta) {
+		return false
+	}
+
+	return !matches(f.Disabled, meta)
+}
+
+// Select returns the registered tools that pass the filter.
+func (f Filter) Select() []Entry {
+	var selected []Entry
+
+	for _, e := range All() {
+		if f.Allows(e.ToolMetadata) {
+			selected = append(selected, e)
+		}
+	}
+
+	return selected
+}
+
+// matches reports whether names lists the tool or its category.
+func matches(names []string, meta types.ToolMetadata) bool {
+	return slices.Contains(names, meta.Name) || slices.Contains(names, meta.Category)
+}
+
+// known reports whether name is a registered tool or category.
+func known(name string) bool {
+	for _, e := range All() {
+		if e.Name == name || e.Category == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,81 @@
+package registry_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/auth"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	_ "github.com/tazapay/tazapay-mcp-server/tools/tazapay"
+)
+
+func names(entries []registry.Entry) []string {
+	out := make([]string, 0, len(entries))
+	for _, e := range entries {
+		out = append(out, e.Name)
+	}
+
+	return out
+}
+
+func TestReadOnlyDropsMutatingTools(t *testing.T) {
+	selected := names(registry.Filter{ReadOnly: true}.Select())
+
+	for _, name := range []string{
+		constants.PaymentLinkToolName, constants.CreateRefundToolName,
+		constants.CreateBeneficiaryToolName, constants.CreatePayoutToolName,
+	} {
+		if slices.Contains(selected, name) {
+			t.Errorf("expected %s to be dropped in read-only mode", name)
+		}
+	}
+
+	if !slices.Contains(selected, constants.GetRefundToolName) || !slices.Contains(selected, constants.QuotePayoutToolName) {
+		t.Errorf("expected read-only tools to stay, got: %v", selected)
+	}
+}
+
+func TestEnabledAndDisabled(t *testing.T) {
+	f := registry.Filter{
+		Enabled:  []string{constants.CategoryRefunds, constants.BalanceToolName},
+		Disabled: []string{constants.CreateRefundToolName},
+	}
+
+	want := []string{constants.BalanceToolName, constants.GetRefundToolName, constants.ListRefundsToolName}
+	if got := names(f.Select()); !slices.Equal(got, want) {
+		t.Errorf("expected %v, got: %v", want, got)
+	}
+}
+
+func TestValidateRejectsUnknownNames(t *testing.T) {
+	f := registry.Filter{Disabled: []string{"tazapay_create_payout"}}
+	if err := f.Validate(); !errors.Is(err, constants.ErrUnknownTool) {
+		t.Errorf("expected ErrUnknownTool, got: %v", err)
+	}
+
+	if err := (registry.Filter{Enabled: []string{constants.CategoryPayouts}}).Validate(); err != nil {
+		t.Errorf("expected a category to be accepted, got: %v", err)
+	}
+}
+
+func TestReadableFollowsScopes(t *testing.T) {
+	payments := registry.Readable(&auth.Principal{Method: auth.MethodOAuth, Scopes: []string{constants.ScopePaymentsRead}})
+
+	for _, name := range []string{constants.PaymentLinkToolName, constants.CreateRefundToolName, constants.BalanceToolName} {
+		if !slices.Contains(payments, name) {
+			t.Errorf("expected payments:read to read %s, got: %v", name, payments)
+		}
+	}
+
+	for _, name := range []string{constants.CreatePayoutToolName, constants.GetPayoutToolName, constants.CreateBeneficiaryToolName} {
+		if slices.Contains(payments, name) {
+			t.Errorf("expected payments:read not to read %s", name)
+		}
+	}
+
+	if all := registry.Readable(&auth.Principal{Method: auth.MethodToken}); len(all) != len(registry.All()) {
+		t.Errorf("expected a static token to read every tool, got: %v", all)
+	}
+}
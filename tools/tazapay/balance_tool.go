@@ -7,18 +7,35 @@ import (
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
 	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/pkg/validation"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
 )
 
 // BalanceTool represents the balance tool
 type BalanceTool struct {
 	logger *slog.Logger
+	client *api.Client
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.BalanceToolName,
+		Category: constants.CategoryBalances,
+		ReadOnly: true,
+		Scope:    constants.ScopePaymentsRead,
+	}, func(deps registry.Deps) types.Tool {
+		return NewBalanceTool(deps.Logger, deps.Client)
+	})
 }
 
 // NewBalanceTool creates a new balance tool
-func NewBalanceTool(logger *slog.Logger) *BalanceTool {
+func NewBalanceTool(logger *slog.Logger, client *api.Client) *BalanceTool {
 	return &BalanceTool{
 		logger: logger,
+		client: client,
 	}
 }
 
@@ -27,32 +44,30 @@ func (t *BalanceTool) Definition() mcp.Tool {
 	return mcp.NewTool(
 		constants.BalanceToolName,
 		mcp.WithDescription(constants.BalanceToolDesc),
+		mcp.WithOutputSchema[types.BalanceDataBlock](),
 		mcp.WithString(constants.BalanceCurrencyField, mcp.Description(constants.BalanceCurrencyDesc)),
 	)
 }
 
 // Handle processes tool requests
 func (t *BalanceTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	args := req.Params.Arguments
-	currency, _ := args["currency"].(string)
+	args := req.GetArguments()
+	currency, _ := args[constants.BalanceCurrencyField].(string)
+
+	v := validation.New()
+	if currency = v.OptionalCurrency(constants.BalanceCurrencyField, currency); v.Err() != nil {
+		return nil, v.Err()
+	}
 
-	path := constants.BalancePath
-	resp, err := utils.HandleGETHttpRequest(ctx, t.logger, constants.BalanceBaseURLProd+path, constants.GetHTTPMethod)
+	balances, err := t.client.GetBalances(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get balance: %w", err)
 	}
 
-	text, err := utils.GetBalances(resp, currency)
+	text, err := utils.FormatBalances(balances, currency)
 	if err != nil {
 		return nil, err
 	}
 
-	return &mcp.CallToolResult{
-		Content: []mcp.Content{
-			mcp.TextContent{
-				Type: "text",
-				Text: text,
-			},
-		},
-	}, nil
+	return utils.StructuredResult(t.client.Environment(), utils.FilterBalances(balances, currency), text), nil
 }
@@ -0,0 +1,260 @@
+package tazapay
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/pkg/validation"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// CreateBeneficiaryTool defines the tool structure
+type CreateBeneficiaryTool struct {
+	logger *slog.Logger
+	client *api.Client
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.CreateBeneficiaryToolName,
+		Category: constants.CategoryBeneficiaries,
+		ReadOnly: false,
+		Scope:    constants.ScopePayoutsWrite,
+		Object:   constants.ObjectBeneficiary,
+	}, func(deps registry.Deps) types.Tool {
+		return NewCreateBeneficiaryTool(deps.Logger, deps.Client)
+	})
+}
+
+// NewCreateBeneficiaryTool returns a new instance of the CreateBeneficiaryTool
+func NewCreateBeneficiaryTool(logger *slog.Logger, client *api.Client) *CreateBeneficiaryTool {
+	logger.Info("Initializing CreateBeneficiaryTool")
+
+	return &CreateBeneficiaryTool{
+		logger: logger,
+		client: client,
+	}
+}
+
+// Definition registers this tool with the MCP platform
+func (*CreateBeneficiaryTool) Definition() mcp.Tool {
+	return mcp.NewTool(
+		constants.CreateBeneficiaryToolName,
+		mcp.WithDescription(constants.CreateBeneficiaryToolDesc),
+		mcp.WithOutputSchema[types.Beneficiary](),
+		mcp.WithString(constants.BeneficiaryNameField, mcp.Required(), mcp.Description(constants.BeneficiaryNameDesc)),
+		mcp.WithString(constants.BeneficiaryTypeField, mcp.Required(), mcp.Description(constants.BeneficiaryTypeDesc),
+			mcp.Enum(constants.BeneficiaryTypeIndividual, constants.BeneficiaryTypeBusiness)),
+		mcp.WithString(constants.BeneficiaryEmailField, mcp.Description(constants.BeneficiaryEmailDesc)),
+		mcp.WithString(constants.DestinationTypeField, mcp.Required(), mcp.Description(constants.DestinationTypeDesc),
+			mcp.Enum(constants.DestinationBank, constants.DestinationWallet, constants.DestinationLocalPaymentNetwork)),
+		mcp.WithString(constants.DestinationCountryField, mcp.Description(constants.DestinationCountryDesc)),
+		mcp.WithString(constants.DestinationCurrencyField, mcp.Required(), mcp.Description(constants.DestinationCurrencyDesc)),
+		mcp.WithString(constants.AccountNumberField, mcp.Description(constants.AccountNumberDesc)),
+		mcp.WithString(constants.BankNameField, mcp.Description(constants.BankNameDesc)),
+		mcp.WithObject(constants.BankCodesField, mcp.Description(constants.BankCodesDesc)),
+		mcp.WithString(constants.WalletTypeField, mcp.Description(constants.WalletTypeDesc)),
+		mcp.WithString(constants.DepositKeyField, mcp.Description(constants.DepositKeyDesc)),
+		mcp.WithString(constants.DepositKeyTypeField, mcp.Description(constants.DepositKeyTypeDesc)),
+	)
+}
+
+// Handle processes the tool request and returns a result
+func (t *CreateBeneficiaryTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+
+	// Account numbers and deposit keys are not logged
+	t.logger.Info("handling create beneficiary tool request", slog.Any("destination_type", args[constants.DestinationTypeField]))
+
+	params, err := validateAndExtractBeneficiaryArgs(t, args)
+	if err != nil {
+		t.logger.Error("argument validation failed", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	payload := NewBeneficiaryRequest(&params)
+
+	beneficiary, err := t.client.CreateBeneficiary(ctx, &payload)
+	if err != nil {
+		t.logger.Error("beneficiary API call failed", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("CreateBeneficiary failed: %w", err)
+	}
+
+	t.logger.Info("beneficiary created", slog.String("beneficiary_id", beneficiary.ID))
+
+	return utils.StructuredResult(t.client.Environment(), beneficiary, utils.FormatBeneficiary(beneficiary)), nil
+}
+
+// Preview describes the beneficiary before it is created
+func (t *CreateBeneficiaryTool) Preview(_ context.Context, req mcp.CallToolRequest) (string, error) {
+	p, err := validateAndExtractBeneficiaryArgs(t, req.GetArguments())
+	if err != nil {
+		return "", err
+	}
+
+	preview := NewBeneficiaryRequest(&p)
+
+	return utils.TagEnvironment(t.client.Environment(), "Create "+
+		utils.FormatBeneficiary(&types.Beneficiary{
+			ID:                 "(new)",
+			Name:               preview.Name,
+			Type:               preview.Type,
+			DestinationDetails: preview.DestinationDetails,
+		})), nil
+}
+
+// validateAndExtractBeneficiaryArgs validates request arguments and returns structured parameters
+func validateAndExtractBeneficiaryArgs(t *CreateBeneficiaryTool, args map[string]any) (types.BeneficiaryParams, error) {
+	var p types.BeneficiaryParams
+	var err error
+
+	if p.Name, err = utils.RequiredString(t.logger, args, constants.BeneficiaryNameField); err != nil {
+		return p, err
+	}
+
+	if p.Type, err = utils.RequiredString(t.logger, args, constants.BeneficiaryTypeField); err != nil {
+		return p, err
+	}
+
+	p.Type = strings.ToLower(p.Type)
+	if p.Type != constants.BeneficiaryTypeIndividual && p.Type != constants.BeneficiaryTypeBusiness {
+		return p, constants.ErrInvalidBeneficiaryType
+	}
+
+	if p.Email, err = utils.OptionalString(t.logger, args, constants.BeneficiaryEmailField); err != nil {
+		return p, err
+	}
+
+	if p.DestinationType, err = utils.RequiredString(t.logger, args, constants.DestinationTypeField); err != nil {
+		return p, err
+	}
+
+	p.DestinationType = strings.ToLower(p.DestinationType)
+
+	if p.Country, err = utils.OptionalString(t.logger, args, constants.DestinationCountryField); err != nil {
+		return p, err
+	}
+
+	if p.Currency, err = utils.RequiredString(t.logger, args, constants.DestinationCurrencyField); err != nil {
+		return p, err
+	}
+
+	v := validation.New()
+
+	p.Email = v.OptionalEmail(constants.BeneficiaryEmailField, p.Email)
+	p.Country = v.OptionalCountry(constants.DestinationCountryField, p.Country)
+	p.Currency = v.Currency(constants.DestinationCurrencyField, p.Currency)
+
+	if err = v.Err(); err != nil {
+		return p, err
+	}
+
+	switch p.DestinationType {
+	case constants.DestinationBank:
+		return p, extractBankArgs(t, args, &p)
+
+	case constants.DestinationWallet, constants.DestinationLocalPaymentNetwork:
+		return p, extractDepositArgs(t, args, &p)
+
+	default:
+		return p, constants.ErrInvalidDestinationType
+	}
+}
+
+// extractBankArgs reads the bank account fields of a bank destination.
+func extractBankArgs(t *CreateBeneficiaryTool, args map[string]any, p *types.BeneficiaryParams) error {
+	var err error
+
+	if p.Country == "" {
+		return fmt.Errorf("%w: %s", constants.ErrMissingRequiredField, constants.DestinationCountryField)
+	}
+
+	if p.AccountNumber, err = utils.OptionalString(t.logger, args, constants.AccountNumberField); err != nil {
+		return err
+	}
+
+	if p.BankName, err = utils.OptionalString(t.logger, args, constants.BankNameField); err != nil {
+		return err
+	}
+
+	if p.BankCodes, err = utils.OptionalStringMap(t.logger, args, constants.BankCodesField); err != nil {
+		return err
+	}
+
+	if p.AccountNumber == "" && p.BankCodes["iban"] == "" {
+		return constants.ErrMissingBankAccount
+	}
+
+	return nil
+}
+
+// extractDepositArgs reads the provider and deposit key of a wallet or local payment network destination.
+func extractDepositArgs(t *CreateBeneficiaryTool, args map[string]any, p *types.BeneficiaryParams) error {
+	var err error
+
+	if p.WalletType, err = utils.RequiredString(t.logger, args, constants.WalletTypeField); err != nil {
+		return err
+	}
+
+	if p.DepositKey, err = utils.RequiredString(t.logger, args, constants.DepositKeyField); err != nil {
+		return err
+	}
+
+	if p.DepositKeyType, err = utils.OptionalString(t.logger, args, constants.DepositKeyTypeField); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewBeneficiaryRequest constructs the API payload from the validated parameters
+func NewBeneficiaryRequest(p *types.BeneficiaryParams) types.BeneficiaryRequest {
+	req := types.BeneficiaryRequest{
+		Name:  p.Name,
+		Type:  p.Type,
+		Email: p.Email,
+		DestinationDetails: types.DestinationDetails{
+			Type: p.DestinationType,
+		},
+	}
+
+	country := strings.ToUpper(p.Country)
+	currency := strings.ToUpper(p.Currency)
+
+	switch p.DestinationType {
+	case constants.DestinationBank:
+		req.DestinationDetails.Bank = &types.BankDetails{
+			AccountNumber: p.AccountNumber,
+			BankName:      p.BankName,
+			Country:       country,
+			Currency:      currency,
+			BankCodes:     p.BankCodes,
+		}
+
+	case constants.DestinationWallet:
+		req.DestinationDetails.Wallet = &types.WalletDetails{
+			Type:       strings.ToLower(p.WalletType),
+			DepositKey: p.DepositKey,
+			Currency:   currency,
+		}
+
+	case constants.DestinationLocalPaymentNetwork:
+		req.DestinationDetails.LocalPaymentNetwork = &types.LocalPaymentNetwork{
+			Type:           strings.ToLower(p.WalletType),
+			DepositKey:     p.DepositKey,
+			DepositKeyType: p.DepositKeyType,
+			Country:        country,
+			Currency:       currency,
+		}
+	}
+
+	return req
+}
@@ -0,0 +1,189 @@
+package tazapay
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/pkg/validation"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// CreateCustomerTool defines the tool structure
+type CreateCustomerTool struct {
+	logger *slog.Logger
+	client *api.Client
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.CreateCustomerToolName,
+		Category: constants.CategoryCustomers,
+		ReadOnly: false,
+		Scope:    constants.ScopePaymentsWrite,
+		Object:   constants.ObjectCustomer,
+	}, func(deps registry.Deps) types.Tool {
+		return NewCreateCustomerTool(deps.Logger, deps.Client)
+	})
+}
+
+// NewCreateCustomerTool returns a new instance of the CreateCustomerTool
+func NewCreateCustomerTool(logger *slog.Logger, client *api.Client) *CreateCustomerTool {
+	logger.Info("Initializing CreateCustomerTool")
+
+	return &CreateCustomerTool{
+		logger: logger,
+		client: client,
+	}
+}
+
+// Definition registers this tool with the MCP platform
+func (*CreateCustomerTool) Definition() mcp.Tool {
+	return mcp.NewTool(
+		constants.CreateCustomerToolName,
+		mcp.WithDescription(constants.CreateCustomerToolDesc),
+		mcp.WithOutputSchema[types.Customer](),
+		mcp.WithString(constants.CustomerNameField, mcp.Required(), mcp.Description(constants.CustomerNameDesc)),
+		mcp.WithString(constants.CustomerEmailField, mcp.Required(), mcp.Description(constants.CustomerEmailDesc)),
+		mcp.WithString(constants.CustomerCountryField, mcp.Required(), mcp.Description(constants.CustomerCountryDesc)),
+		mcp.WithString(constants.CustomerPhoneCodeField, mcp.Description(constants.CustomerPhoneCodeDesc)),
+		mcp.WithString(constants.CustomerPhoneField, mcp.Description(constants.CustomerPhoneDesc)),
+		mcp.WithString(constants.CustomerReferenceField, mcp.Description(constants.CustomerReferenceDesc)),
+		mcp.WithObject(constants.MetadataField, mcp.Description(constants.CustomerMetadataDesc),
+			mcp.AdditionalProperties(map[string]any{"type": "string"})),
+	)
+}
+
+// Handle processes the tool request and returns a result
+func (t *CreateCustomerTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+
+	p, err := extractCustomerArgs(t.logger, args, true)
+	if err != nil {
+		t.logger.Error("argument validation failed", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	// Emails identify customers, so an existing customer is reused rather than duplicated
+	existing, err := t.client.ListCustomers(ctx, types.ListCustomersParams{Email: p.Email})
+	if err != nil {
+		t.logger.Error("customer API call failed", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("ListCustomers failed: %w", err)
+	}
+
+	for i := range existing.Data {
+		if customer := &existing.Data[i]; strings.EqualFold(customer.Email, p.Email) {
+			t.logger.Info("customer already exists", slog.String("customer_id", customer.ID))
+
+			return utils.StructuredResult(t.client.Environment(), customer,
+				"A customer with this email already exists; it was not changed.\n"+utils.FormatCustomer(customer)), nil
+		}
+	}
+
+	payload := NewCustomerRequest(&p)
+
+	customer, err := t.client.CreateCustomer(ctx, &payload)
+	if err != nil {
+		t.logger.Error("customer API call failed", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("CreateCustomer failed: %w", err)
+	}
+
+	t.logger.Info("customer created", slog.String("customer_id", customer.ID))
+
+	return utils.StructuredResult(t.client.Environment(), customer, utils.FormatCustomer(customer)), nil
+}
+
+// extractCustomerArgs validates the customer fields. When required is set,
+// name, email and country must be present; otherwise every field is optional.
+func extractCustomerArgs(logger *slog.Logger, args map[string]any, required bool) (types.CustomerParams, error) {
+	var p types.CustomerParams
+	var err error
+
+	for _, opt := range []struct {
+		field string
+		dst   *string
+	}{
+		{constants.CustomerNameField, &p.Name},
+		{constants.CustomerEmailField, &p.Email},
+		{constants.CustomerCountryField, &p.Country},
+		{constants.CustomerPhoneCodeField, &p.PhoneCallingCode},
+		{constants.CustomerPhoneField, &p.PhoneNumber},
+		{constants.CustomerReferenceField, &p.ReferenceID},
+	} {
+		if *opt.dst, err = utils.OptionalString(logger, args, opt.field); err != nil {
+			return p, err
+		}
+	}
+
+	if p.Metadata, err = utils.OptionalStringMap(logger, args, constants.MetadataField); err != nil {
+		return p, err
+	}
+
+	v := validation.New()
+
+	if required {
+		p.Name = v.Required(constants.CustomerNameField, p.Name)
+		p.Email = v.Email(constants.CustomerEmailField, p.Email)
+		p.Country = v.Country(constants.CustomerCountryField, p.Country)
+	} else {
+		p.Name = strings.TrimSpace(p.Name)
+		p.Email = v.OptionalEmail(constants.CustomerEmailField, p.Email)
+		p.Country = v.OptionalCountry(constants.CustomerCountryField, p.Country)
+	}
+
+	p.ReferenceID = strings.TrimSpace(p.ReferenceID)
+	p.PhoneCallingCode = strings.TrimPrefix(strings.TrimSpace(p.PhoneCallingCode), "+")
+	p.PhoneNumber = strings.NewReplacer(" ", "", "-", "").Replace(p.PhoneNumber)
+
+	switch {
+	case p.PhoneNumber != "" && p.PhoneCallingCode == "":
+		v.Add(constants.CustomerPhoneCodeField, "is required with "+constants.CustomerPhoneField)
+
+	case p.PhoneCallingCode != "" && p.PhoneNumber == "":
+		v.Add(constants.CustomerPhoneField, "is required with "+constants.CustomerPhoneCodeField)
+	}
+
+	if !digits(p.PhoneCallingCode) {
+		v.Add(constants.CustomerPhoneCodeField, "must only contain digits")
+	}
+
+	if !digits(p.PhoneNumber) {
+		v.Add(constants.CustomerPhoneField, "must only contain digits")
+	}
+
+	if len(p.Metadata) > constants.MaxMetadataKeys {
+		v.Add(constants.MetadataField, fmt.Sprintf("has %d keys; at most %d are allowed",
+			len(p.Metadata), constants.MaxMetadataKeys))
+	}
+
+	return p, v.Err()
+}
+
+// digits reports whether s only contains ASCII digits.
+func digits(s string) bool {
+	return strings.Trim(s, "0123456789") == ""
+}
+
+// NewCustomerRequest constructs the API payload from the validated parameters
+func NewCustomerRequest(p *types.CustomerParams) types.CustomerRequest {
+	req := types.CustomerRequest{
+		Name:        p.Name,
+		Email:       p.Email,
+		Country:     p.Country,
+		ReferenceID: p.ReferenceID,
+		Metadata:    p.Metadata,
+	}
+
+	if p.PhoneNumber != "" {
+		req.Phone = &types.Phone{CallingCode: p.PhoneCallingCode, Number: p.PhoneNumber}
+	}
+
+	return req
+}
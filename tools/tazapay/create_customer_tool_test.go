@@ -0,0 +1,97 @@
+package tazapay_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/tools/tazapay"
+)
+
+// customerAPI creates and updates customers, and finds none by email.
+func customerAPI(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodGet {
+		_, _ = io.WriteString(w, `{"status":"success","data":{"data":[],"has_more":false}}`)
+		return
+	}
+
+	_, _ = io.WriteString(w, `{"status":"success","data":{"id":"cus_1","name":"Ada Lovelace","email":"ada@example.com"}}`)
+}
+
+func TestCreateCustomerArguments(t *testing.T) {
+	tool := tazapay.NewCreateCustomerTool(discard, newTestClient(t, customerAPI))
+
+	valid := map[string]any{
+		constants.CustomerNameField:    "Ada Lovelace",
+		constants.CustomerEmailField:   "ada@example.com",
+		constants.CustomerCountryField: "GB",
+	}
+
+	runCases(t, []argsCase{
+		{name: "required fields", args: valid},
+		{name: "country name", args: with(valid, constants.CustomerCountryField, "United Kingdom")},
+		{
+			name: "phone number",
+			args: with(valid, constants.CustomerPhoneCodeField, "+44", constants.CustomerPhoneField, "7700 900-123"),
+		},
+		{
+			name:    "no name",
+			args:    with(valid, constants.CustomerNameField, nil),
+			wantErr: constants.ErrInvalidArguments,
+			want:    constants.CustomerNameField + ": is required",
+		},
+		{
+			name:    "invalid email",
+			args:    with(valid, constants.CustomerEmailField, "ada.example.com"),
+			wantErr: constants.ErrInvalidArguments,
+			want:    constants.CustomerEmailField,
+		},
+		{
+			name:    "unknown country",
+			args:    with(valid, constants.CustomerCountryField, "Atlantis"),
+			wantErr: constants.ErrInvalidArguments,
+			want:    constants.CustomerCountryField,
+		},
+		{
+			name:    "phone number without calling code",
+			args:    with(valid, constants.CustomerPhoneField, "7700900123"),
+			wantErr: constants.ErrInvalidArguments,
+			want:    constants.CustomerPhoneCodeField + ": is required",
+		},
+		{
+			name:    "phone number with letters",
+			args:    with(valid, constants.CustomerPhoneCodeField, "44", constants.CustomerPhoneField, "7700-CALL-ME"),
+			wantErr: constants.ErrInvalidArguments,
+			want:    constants.CustomerPhoneField + ": must only contain digits",
+		},
+	}, func(args map[string]any) error {
+		_, err := tool.Handle(t.Context(), request(constants.CreateCustomerToolName, args))
+		return err
+	})
+}
+
+func TestUpdateCustomerArguments(t *testing.T) {
+	tool := tazapay.NewUpdateCustomerTool(discard, newTestClient(t, customerAPI))
+
+	id := map[string]any{constants.CustomerIDField: "cus_1"}
+
+	runCases(t, []argsCase{
+		{name: "one field", args: with(id, constants.CustomerReferenceField, "crm-42")},
+		{name: "no changes", args: id, wantErr: constants.ErrNoCustomerChanges},
+		{
+			name:    "invalid email",
+			args:    with(id, constants.CustomerEmailField, "ada@"),
+			wantErr: constants.ErrInvalidArguments,
+			want:    constants.CustomerEmailField,
+		},
+		{
+			name:    "no customer ID",
+			args:    map[string]any{constants.CustomerReferenceField: "crm-42"},
+			wantErr: constants.ErrInvalidType,
+		},
+	}, func(args map[string]any) error {
+		_, err := tool.Handle(t.Context(), request(constants.UpdateCustomerToolName, args))
+		return err
+	})
+}
@@ -0,0 +1,160 @@
+package tazapay
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/pkg/validation"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// CreatePayoutTool defines the tool structure
+type CreatePayoutTool struct {
+	logger *slog.Logger
+	client *api.Client
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.CreatePayoutToolName,
+		Category: constants.CategoryPayouts,
+		ReadOnly: false,
+		Scope:    constants.ScopePayoutsWrite,
+		Object:   constants.ObjectPayout,
+	}, func(deps registry.Deps) types.Tool {
+		return NewCreatePayoutTool(deps.Logger, deps.Client)
+	})
+}
+
+// NewCreatePayoutTool returns a new instance of the CreatePayoutTool
+func NewCreatePayoutTool(logger *slog.Logger, client *api.Client) *CreatePayoutTool {
+	logger.Info("Initializing CreatePayoutTool")
+
+	return &CreatePayoutTool{
+		logger: logger,
+		client: client,
+	}
+}
+
+// Definition registers this tool with the MCP platform
+func (*CreatePayoutTool) Definition() mcp.Tool {
+	return mcp.NewTool(
+		constants.CreatePayoutToolName,
+		mcp.WithDescription(constants.CreatePayoutToolDesc),
+		mcp.WithOutputSchema[types.Payout](),
+		mcp.WithString(constants.PayoutBeneficiaryField, mcp.Required(), mcp.Description(constants.PayoutBeneficiaryDesc)),
+		mcp.WithNumber(constants.PayoutAmountField, mcp.Required(), mcp.Description(constants.PayoutAmountDesc)),
+		mcp.WithString(constants.PayoutCurrencyField, mcp.Required(), mcp.Description(constants.PayoutCurrencyDesc)),
+		mcp.WithString(constants.HoldingCurrencyField, mcp.Required(), mcp.Description(constants.HoldingCurrencyDesc)),
+		mcp.WithString(constants.PayoutPurposeField, mcp.Required(), mcp.Description(constants.PayoutPurposeDesc)),
+		mcp.WithString(constants.PayoutDescriptionField, mcp.Description(constants.PayoutDescriptionDesc)),
+		mcp.WithString(constants.PayoutReferenceField, mcp.Description(constants.PayoutReferenceDesc)),
+	)
+}
+
+// Handle processes the tool request and returns a result
+func (t *CreatePayoutTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+
+	t.logger.Info("handling create payout tool request", slog.Any("args", args))
+
+	params, err := validateAndExtractPayoutArgs(t, args)
+	if err != nil {
+		t.logger.Error("argument validation failed", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	payload := NewPayoutRequest(&params)
+
+	payout, err := t.client.CreatePayout(ctx, &payload)
+	if err != nil {
+		t.logger.Error("payout API call failed", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("CreatePayout failed: %w", err)
+	}
+
+	t.logger.Info("payout created", slog.String("payout_id", payout.ID), slog.String("status", payout.Status))
+
+	return utils.StructuredResult(t.client.Environment(), payout, utils.FormatPayout(payout)), nil
+}
+
+// Preview describes the payout, including the FX quote, before it is created
+func (t *CreatePayoutTool) Preview(ctx context.Context, req mcp.CallToolRequest) (string, error) {
+	p, err := validateAndExtractPayoutArgs(t, req.GetArguments())
+	if err != nil {
+		return "", err
+	}
+
+	quote, err := t.client.QuotePayout(ctx, p.HoldingCurrency, p.Amount)
+	if err != nil {
+		return "", fmt.Errorf("QuotePayout failed: %w", err)
+	}
+
+	return utils.TagEnvironment(t.client.Environment(), fmt.Sprintf(
+		"Pay %s to beneficiary %s from the %s balance (purpose %s).\n%s",
+		p.Amount.Display(), p.Beneficiary, strings.ToUpper(p.HoldingCurrency), p.Purpose,
+		utils.FormatPayoutQuote(quote),
+	)), nil
+}
+
+// validateAndExtractPayoutArgs validates request arguments and returns structured parameters
+func validateAndExtractPayoutArgs(t *CreatePayoutTool, args map[string]any) (types.PayoutParams, error) {
+	var p types.PayoutParams
+	var err error
+
+	if p.Beneficiary, err = utils.RequiredString(t.logger, args, constants.PayoutBeneficiaryField); err != nil {
+		return p, err
+	}
+
+	if p.Currency, err = utils.RequiredString(t.logger, args, constants.PayoutCurrencyField); err != nil {
+		return p, err
+	}
+
+	if p.HoldingCurrency, err = utils.RequiredString(t.logger, args, constants.HoldingCurrencyField); err != nil {
+		return p, err
+	}
+
+	v := validation.New()
+
+	p.Currency = v.Currency(constants.PayoutCurrencyField, p.Currency)
+	p.HoldingCurrency = v.Currency(constants.HoldingCurrencyField, p.HoldingCurrency)
+	p.Amount = utils.ValidAmount(t.logger, v, args, constants.PayoutAmountField, constants.PayoutCurrencyField, p.Currency)
+
+	if err = v.Err(); err != nil {
+		return p, err
+	}
+
+	if p.Purpose, err = utils.RequiredString(t.logger, args, constants.PayoutPurposeField); err != nil {
+		return p, err
+	}
+
+	if p.Description, err = utils.OptionalString(t.logger, args, constants.PayoutDescriptionField); err != nil {
+		return p, err
+	}
+
+	if p.ReferenceID, err = utils.OptionalString(t.logger, args, constants.PayoutReferenceField); err != nil {
+		return p, err
+	}
+
+	return p, nil
+}
+
+// NewPayoutRequest constructs the API payload from the validated parameters
+func NewPayoutRequest(p *types.PayoutParams) types.PayoutRequest {
+	return types.PayoutRequest{
+		Beneficiary:            p.Beneficiary,
+		Amount:                 p.Amount.Minor,
+		Currency:               p.Amount.Currency,
+		HoldingCurrency:        strings.ToUpper(p.HoldingCurrency),
+		Purpose:                p.Purpose,
+		TransactionDescription: p.Description,
+		ReferenceID:            p.ReferenceID,
+	}
+}
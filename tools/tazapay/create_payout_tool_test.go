@@ -0,0 +1,61 @@
+package tazapay_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/tools/tazapay"
+)
+
+func TestCreatePayoutArguments(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, `{"status":"success","data":{"exchange_rate":80,"converted_amount":8000}}`)
+	})
+	tool := tazapay.NewCreatePayoutTool(discard, client)
+
+	valid := map[string]any{
+		constants.PayoutBeneficiaryField: "bnf_1",
+		constants.PayoutAmountField:      1000.0,
+		constants.PayoutCurrencyField:    "INR",
+		constants.HoldingCurrencyField:   "USD",
+		constants.PayoutPurposeField:     "PYR001",
+	}
+
+	runCases(t, []argsCase{
+		{name: "payout with FX", args: valid},
+		{name: "currency name", args: with(valid, constants.PayoutCurrencyField, "indian rupees")},
+		{
+			name:    "ambiguous currency name",
+			args:    with(valid, constants.PayoutCurrencyField, "rupees"),
+			wantErr: constants.ErrInvalidArguments,
+			want:    "did you mean INR, PKR",
+		},
+		{
+			name:    "zero amount",
+			args:    with(valid, constants.PayoutAmountField, 0.0),
+			wantErr: constants.ErrInvalidArguments,
+			want:    constants.PayoutAmountField + ": must be greater than zero",
+		},
+		{
+			name:    "more decimals than the currency",
+			args:    with(valid, constants.PayoutCurrencyField, "JPY", constants.PayoutAmountField, 10.5),
+			wantErr: constants.ErrInvalidArguments,
+			want:    constants.PayoutAmountField,
+		},
+		{
+			name:    "no beneficiary",
+			args:    with(valid, constants.PayoutBeneficiaryField, nil),
+			wantErr: constants.ErrInvalidType,
+		},
+		{
+			name:    "no purpose",
+			args:    with(valid, constants.PayoutPurposeField, ""),
+			wantErr: constants.ErrMissingRequiredField,
+		},
+	}, func(args map[string]any) error {
+		_, err := tool.Preview(t.Context(), request(constants.CreatePayoutToolName, args))
+		return err
+	})
+}
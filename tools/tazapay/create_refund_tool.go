@@ -0,0 +1,175 @@
+package tazapay
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/pkg/validation"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// CreateRefundTool defines the tool structure
+type CreateRefundTool struct {
+	logger *slog.Logger
+	client *api.Client
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.CreateRefundToolName,
+		Category: constants.CategoryRefunds,
+		ReadOnly: false,
+		Scope:    constants.ScopePaymentsWrite,
+		Object:   constants.ObjectRefund,
+	}, func(deps registry.Deps) types.Tool {
+		return NewCreateRefundTool(deps.Logger, deps.Client)
+	})
+}
+
+// NewCreateRefundTool returns a new instance of the CreateRefundTool
+func NewCreateRefundTool(logger *slog.Logger, client *api.Client) *CreateRefundTool {
+	logger.Info("Initializing CreateRefundTool")
+
+	return &CreateRefundTool{
+		logger: logger,
+		client: client,
+	}
+}
+
+// Definition registers this tool with the MCP platform
+func (*CreateRefundTool) Definition() mcp.Tool {
+	return mcp.NewTool(
+		constants.CreateRefundToolName,
+		mcp.WithDescription(constants.CreateRefundToolDesc),
+		mcp.WithOutputSchema[types.Refund](),
+		mcp.WithString(constants.RefundPayinField, mcp.Description(constants.RefundPayinDesc)),
+		mcp.WithString(constants.RefundCheckoutField, mcp.Description(constants.RefundCheckoutDesc)),
+		mcp.WithNumber(constants.RefundAmountField, mcp.Description(constants.RefundAmountDesc)),
+		mcp.WithString(constants.RefundCurrencyField, mcp.Description(constants.RefundCurrencyDesc)),
+		mcp.WithString(constants.RefundReasonField, mcp.Required(), mcp.Description(constants.RefundReasonDesc)),
+		mcp.WithString(constants.RefundReferenceField, mcp.Description(constants.RefundReferenceDesc)),
+	)
+}
+
+// Handle processes the tool request and returns a result
+func (t *CreateRefundTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+
+	t.logger.Info("handling create refund tool request", slog.Any("args", args))
+
+	params, err := validateAndExtractRefundArgs(t, args)
+	if err != nil {
+		t.logger.Error("argument validation failed", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	payload := NewRefundRequest(&params)
+
+	refund, err := t.client.CreateRefund(ctx, &payload)
+	if err != nil {
+		t.logger.Error("refund API call failed", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("CreateRefund failed: %w", err)
+	}
+
+	t.logger.Info("refund created", slog.String("refund_id", refund.ID), slog.String("status", refund.Status))
+
+	return utils.StructuredResult(t.client.Environment(), refund, utils.FormatRefund(refund)), nil
+}
+
+// Preview describes the refund before it is created
+func (t *CreateRefundTool) Preview(_ context.Context, req mcp.CallToolRequest) (string, error) {
+	p, err := validateAndExtractRefundArgs(t, req.GetArguments())
+	if err != nil {
+		return "", err
+	}
+
+	target := "payin " + p.Payin
+	if p.Payin == "" {
+		target = "checkout " + p.Checkout
+	}
+
+	amount := "the full amount"
+	if p.Amount.IsPositive() {
+		amount = p.Amount.Display()
+	}
+
+	return utils.TagEnvironment(t.client.Environment(),
+		fmt.Sprintf("Refund %s of %s. Reason: %s", amount, target, p.Reason)), nil
+}
+
+// validateAndExtractRefundArgs validates request arguments and returns structured parameters
+func validateAndExtractRefundArgs(t *CreateRefundTool, args map[string]any) (types.RefundParams, error) {
+	var p types.RefundParams
+	var err error
+
+	if p.Payin, err = utils.OptionalString(t.logger, args, constants.RefundPayinField); err != nil {
+		return p, err
+	}
+
+	if p.Checkout, err = utils.OptionalString(t.logger, args, constants.RefundCheckoutField); err != nil {
+		return p, err
+	}
+
+	if p.Payin == "" && p.Checkout == "" {
+		return p, constants.ErrMissingRefundTarget
+	}
+
+	if p.Currency, err = utils.OptionalString(t.logger, args, constants.RefundCurrencyField); err != nil {
+		return p, err
+	}
+
+	v := validation.New()
+
+	p.Currency = v.OptionalCurrency(constants.RefundCurrencyField, p.Currency)
+
+	// Only a missing amount means a full refund; a given amount, even 0, must
+	// be valid in its currency, so it is only parsed once that is known
+	if partial := args[constants.RefundAmountField] != nil; partial && v.Valid(constants.RefundCurrencyField) {
+		if p.Currency == "" {
+			return p, constants.ErrMissingRefundCurrency
+		}
+
+		p.Amount, err = utils.OptionalAmount(t.logger, args, constants.RefundAmountField, p.Currency)
+		if err != nil {
+			v.Add(constants.RefundAmountField, strings.TrimPrefix(err.Error(), constants.RefundAmountField+": "))
+		} else {
+			v.Amount(constants.RefundAmountField, p.Amount)
+		}
+	}
+
+	if p.Reason, err = utils.RequiredString(t.logger, args, constants.RefundReasonField); err != nil {
+		return p, err
+	}
+
+	if p.ReferenceID, err = utils.OptionalString(t.logger, args, constants.RefundReferenceField); err != nil {
+		return p, err
+	}
+
+	return p, v.Err()
+}
+
+// NewRefundRequest constructs the API payload from the validated parameters.
+// Amount and currency are omitted for a full refund.
+func NewRefundRequest(p *types.RefundParams) types.RefundRequest {
+	req := types.RefundRequest{
+		Payin:       p.Payin,
+		Checkout:    p.Checkout,
+		Reason:      p.Reason,
+		ReferenceID: p.ReferenceID,
+	}
+
+	if p.Amount.IsPositive() {
+		req.Amount = p.Amount.Minor
+		req.Currency = p.Amount.Currency
+	}
+
+	return req
+}
@@ -0,0 +1,74 @@
+package tazapay_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/tools/tazapay"
+)
+
+func TestCreateRefundArguments(t *testing.T) {
+	tool := tazapay.NewCreateRefundTool(discard, newTestClient(t, nil))
+
+	full := map[string]any{constants.RefundPayinField: "pay_1", constants.RefundReasonField: "duplicate"}
+	partial := with(full, constants.RefundAmountField, 10.5, constants.RefundCurrencyField, "USD")
+
+	runCases(t, []argsCase{
+		{name: "full refund", args: full},
+		{name: "partial refund", args: partial},
+		{name: "amount as a decimal string", args: with(partial, constants.RefundAmountField, "10.50")},
+		{
+			name:    "explicit zero amount",
+			args:    with(partial, constants.RefundAmountField, 0.0),
+			wantErr: constants.ErrInvalidArguments,
+			want:    constants.RefundAmountField + ": must be greater than zero",
+		},
+		{
+			name:    "zero amount without currency",
+			args:    with(full, constants.RefundAmountField, 0.0),
+			wantErr: constants.ErrMissingRefundCurrency,
+		},
+		{
+			name:    "amount in an unknown currency",
+			args:    with(partial, constants.RefundAmountField, "10.123", constants.RefundCurrencyField, "dollars"),
+			wantErr: constants.ErrInvalidArguments,
+			want:    constants.RefundCurrencyField + `: "dollars" is not an ISO 4217 currency code`,
+		},
+		{
+			name:    "amount too precise for its currency",
+			args:    with(partial, constants.RefundAmountField, "10.5", constants.RefundCurrencyField, "JPY"),
+			wantErr: constants.ErrInvalidArguments,
+			want:    constants.RefundAmountField + ": too many decimal places",
+		},
+		{
+			name:    "negative amount",
+			args:    with(partial, constants.RefundAmountField, -5.0),
+			wantErr: constants.ErrInvalidArguments,
+			want:    constants.RefundAmountField + ": must be greater than zero",
+		},
+		{
+			name:    "partial refund without currency",
+			args:    with(full, constants.RefundAmountField, "10.123"),
+			wantErr: constants.ErrMissingRefundCurrency,
+		},
+		{
+			name:    "no payin or checkout",
+			args:    with(full, constants.RefundPayinField, nil),
+			wantErr: constants.ErrMissingRefundTarget,
+		},
+		{
+			name:    "no reason",
+			args:    with(full, constants.RefundReasonField, ""),
+			wantErr: constants.ErrMissingRequiredField,
+		},
+	}, func(args map[string]any) error {
+		_, err := tool.Preview(t.Context(), request(constants.CreateRefundToolName, args))
+		return err
+	})
+
+	preview, err := tool.Preview(t.Context(), request(constants.CreateRefundToolName, full))
+	if err != nil || !strings.Contains(preview, "the full amount") {
+		t.Errorf("expected a missing amount to preview a full refund, got: %q, %v", preview, err)
+	}
+}
@@ -0,0 +1,69 @@
+package tazapay
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/decline"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// ExplainDeclineTool defines the tool structure
+type ExplainDeclineTool struct {
+	logger *slog.Logger
+	client *api.Client
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.ExplainDeclineToolName,
+		Category: constants.CategoryPayins,
+		ReadOnly: true,
+		Scope:    constants.ScopePaymentsRead,
+	}, func(deps registry.Deps) types.Tool {
+		return NewExplainDeclineTool(deps.Logger, deps.Client)
+	})
+}
+
+// NewExplainDeclineTool returns a new instance of the ExplainDeclineTool
+func NewExplainDeclineTool(logger *slog.Logger, client *api.Client) *ExplainDeclineTool {
+	logger.Info("Initializing ExplainDeclineTool")
+
+	return &ExplainDeclineTool{
+		logger: logger,
+		client: client,
+	}
+}
+
+// Definition registers this tool with the MCP platform
+func (*ExplainDeclineTool) Definition() mcp.Tool {
+	return mcp.NewTool(
+		constants.ExplainDeclineToolName,
+		mcp.WithDescription(constants.ExplainDeclineToolDesc),
+		mcp.WithOutputSchema[decline.Explanation](),
+		mcp.WithString(constants.DeclineCodeField, mcp.Required(), mcp.Description(constants.DeclineCodeDesc)),
+	)
+}
+
+// Handle processes the tool request and returns a result
+func (t *ExplainDeclineTool) Handle(_ context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	code, err := utils.RequiredString(t.logger, req.GetArguments(), constants.DeclineCodeField)
+	if err != nil {
+		return nil, err
+	}
+
+	e := decline.Explain(code)
+
+	text := e.Code + ": " + e.Text()
+	if e.Retryable {
+		text += " Retrying may succeed."
+	}
+
+	return utils.StructuredResult(t.client.Environment(), e, text), nil
+}
@@ -4,25 +4,43 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
 
 	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
 	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/pkg/validation"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
 	"github.com/tazapay/tazapay-mcp-server/types"
 )
 
 // FXTool defines the tool structure
 type FXTool struct {
 	logger *slog.Logger
+	client *api.Client
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.FXToolName,
+		Category: constants.CategoryFX,
+		ReadOnly: true,
+		Scope:    constants.ScopePaymentsRead,
+		Object:   constants.ObjectFXQuote,
+	}, func(deps registry.Deps) types.Tool {
+		return NewFXTool(deps.Logger, deps.Client)
+	})
 }
 
 // NewFXTool returns a new instance of the FXTool
-func NewFXTool(logger *slog.Logger) *FXTool {
-	logger.Info("Initializing FXTool")
+func NewFXTool(logger *slog.Logger, client *api.Client) *FXTool {
+	logger.Info("Initializing FXTool", slog.String("environment", client.Environment().Name))
 
 	return &FXTool{
 		logger: logger,
+		client: client,
 	}
 }
 
@@ -31,17 +49,20 @@ func (*FXTool) Definition() mcp.Tool {
 	return mcp.NewTool(
 		constants.FXToolName,
 		mcp.WithDescription(constants.FXToolDescription),
+		mcp.WithOutputSchema[types.FXQuote](),
 		mcp.WithString(constants.FXFromField, mcp.Required(), mcp.Description(constants.FXFromDescription)),
 		mcp.WithString(constants.FXToField, mcp.Required(), mcp.Description(constants.FXToDescription)),
 		mcp.WithNumber(constants.FXAmountField, mcp.Required(), mcp.Description(constants.FXAmountDescription)),
+		mcp.WithString(constants.FXFixedSideField, mcp.Description(constants.FXFixedSideDescription),
+			mcp.Enum(constants.FXFixedSource, constants.FXFixedDestination)),
 	)
 }
 
 // Handle processes the tool request and returns a result
 func (t *FXTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	t.logger.Info("Handling FXTool request", slog.Any("params", req.Params.Arguments))
+	t.logger.Info("Handling FXTool request", slog.Any("params", req.GetArguments()))
 
-	args := req.Params.Arguments
+	args := req.GetArguments()
 
 	// validate and extract arguments
 	params, err := validateAndExtractFXArgs(t, args)
@@ -50,60 +71,28 @@ func (t *FXTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.Call
 		return nil, err
 	}
 
-	// construct URL for API call
-	url := fmt.Sprintf("%s?initial_currency=%s&final_currency=%s&amount=%d",
-		constants.PaymentFxBaseURLProd, params.From, params.To, int(params.Amount))
-
-	t.logger.Info("Calling FX API", slog.String("url", url))
+	t.logger.Info("Calling FX API", slog.String("from", params.From), slog.String("to", params.To),
+		slog.String("fixed_side", params.FixedSide))
 
 	// call FX API
-	resp, err := utils.HandleGETHttpRequest(ctx, t.logger, url, constants.GetHTTPMethod)
+	quote, err := t.client.QuoteFX(ctx, params.From, params.To, params.Amount, params.FixedSide)
 	if err != nil {
 		t.logger.Error("FX API call failed", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("HandleGETHttpRequest failed: %w", err)
+		return nil, fmt.Errorf("GetFXRate failed: %w", err)
 	}
 
-	// extract required fields from response
-	data, ok := resp["data"].(map[string]any)
-	if !ok {
-		t.logger.Error("No 'data' in FX API response")
-		return nil, constants.ErrNoDataInResponse
-	}
-
-	exRate, ok1 := data["exchange_rate"].(float64)
-	if !ok1 {
-		t.logger.Error("Invalid type for exchange_rate")
-		return nil, utils.WrapFieldTypeError(t.logger, "exchange_rate")
-	}
-
-	converted, ok2 := data["converted_amount"].(float64)
-	if !ok2 {
-		t.logger.Error("Invalid type for converted_amount")
-		return nil, utils.WrapFieldTypeError(t.logger, "converted_amount")
-	}
-
-	result := fmt.Sprintf("Rate: %.2f, Converted Amount: %.2f", exRate, converted)
-	t.logger.Info("FXTool result ready", slog.String("result", result))
+	result := utils.FormatFXQuote(quote)
+	t.logger.Info("FXTool result ready", slog.String("from", quote.From), slog.String("to", quote.To))
 
 	// return result
-	return &mcp.CallToolResult{
-		Content: []mcp.Content{
-			mcp.TextContent{
-				Type: "text",
-				Text: result,
-			},
-		},
-	}, nil
+	return utils.StructuredResult(t.client.Environment(), quote, result), nil
 }
 
 // validateAndExtractFXArgs validates request arguments and returns structured parameters
 func validateAndExtractFXArgs(t *FXTool, args map[string]any) (types.FXParams, error) {
 	var p types.FXParams
 	var ok bool
-
-	if p.Amount, ok = args[constants.FXAmountField].(float64); !ok {
-		return p, utils.WrapFieldTypeError(t.logger, constants.FXAmountField)
-	}
+	var err error
 
 	if p.From, ok = args[constants.FXFromField].(string); !ok {
 		return p, utils.WrapFieldTypeError(t.logger, constants.FXFromField)
@@ -113,5 +102,32 @@ func validateAndExtractFXArgs(t *FXTool, args map[string]any) (types.FXParams, e
 		return p, utils.WrapFieldTypeError(t.logger, constants.FXToField)
 	}
 
-	return p, nil
+	if p.FixedSide, err = utils.OptionalString(t.logger, args, constants.FXFixedSideField); err != nil {
+		return p, err
+	}
+
+	switch p.FixedSide = strings.ToLower(p.FixedSide); p.FixedSide {
+	case "":
+		p.FixedSide = constants.FXFixedSource
+
+	case constants.FXFixedSource, constants.FXFixedDestination:
+
+	default:
+		return p, constants.ErrInvalidFixedSide
+	}
+
+	v := validation.New()
+
+	p.From = v.Currency(constants.FXFromField, p.From)
+	p.To = v.Currency(constants.FXToField, p.To)
+
+	// The amount is in whichever currency is fixed, which decides its decimal places
+	amountCurrency, currencyField := p.From, constants.FXFromField
+	if p.FixedSide == constants.FXFixedDestination {
+		amountCurrency, currencyField = p.To, constants.FXToField
+	}
+
+	p.Amount = utils.ValidAmount(t.logger, v, args, constants.FXAmountField, currencyField, amountCurrency)
+
+	return p, v.Err()
 }
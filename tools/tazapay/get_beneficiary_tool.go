@@ -0,0 +1,68 @@
+package tazapay
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// GetBeneficiaryTool defines the tool structure
+type GetBeneficiaryTool struct {
+	logger *slog.Logger
+	client *api.Client
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.GetBeneficiaryToolName,
+		Category: constants.CategoryBeneficiaries,
+		ReadOnly: true,
+		Scope:    constants.ScopePayoutsRead,
+	}, func(deps registry.Deps) types.Tool {
+		return NewGetBeneficiaryTool(deps.Logger, deps.Client)
+	})
+}
+
+// NewGetBeneficiaryTool returns a new instance of the GetBeneficiaryTool
+func NewGetBeneficiaryTool(logger *slog.Logger, client *api.Client) *GetBeneficiaryTool {
+	logger.Info("Initializing GetBeneficiaryTool")
+
+	return &GetBeneficiaryTool{
+		logger: logger,
+		client: client,
+	}
+}
+
+// Definition registers this tool with the MCP platform
+func (*GetBeneficiaryTool) Definition() mcp.Tool {
+	return mcp.NewTool(
+		constants.GetBeneficiaryToolName,
+		mcp.WithDescription(constants.GetBeneficiaryToolDesc),
+		mcp.WithOutputSchema[types.Beneficiary](),
+		mcp.WithString(constants.BeneficiaryIDField, mcp.Required(), mcp.Description(constants.BeneficiaryIDDesc)),
+	)
+}
+
+// Handle processes the tool request and returns a result
+func (t *GetBeneficiaryTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, err := utils.RequiredString(t.logger, req.GetArguments(), constants.BeneficiaryIDField)
+	if err != nil {
+		return nil, err
+	}
+
+	beneficiary, err := t.client.GetBeneficiary(ctx, id)
+	if err != nil {
+		t.logger.Error("beneficiary API call failed", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("GetBeneficiary failed: %w", err)
+	}
+
+	return utils.StructuredResult(t.client.Environment(), beneficiary, utils.FormatBeneficiary(beneficiary)), nil
+}
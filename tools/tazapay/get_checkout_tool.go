@@ -0,0 +1,110 @@
+package tazapay
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// GetCheckoutTool defines the tool structure
+type GetCheckoutTool struct {
+	logger *slog.Logger
+	client *api.Client
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.GetCheckoutToolName,
+		Category: constants.CategoryCheckouts,
+		ReadOnly: true,
+		Scope:    constants.ScopePaymentsRead,
+	}, func(deps registry.Deps) types.Tool {
+		return NewGetCheckoutTool(deps.Logger, deps.Client)
+	})
+}
+
+// NewGetCheckoutTool returns a new instance of the GetCheckoutTool
+func NewGetCheckoutTool(logger *slog.Logger, client *api.Client) *GetCheckoutTool {
+	logger.Info("Initializing GetCheckoutTool")
+
+	return &GetCheckoutTool{
+		logger: logger,
+		client: client,
+	}
+}
+
+// Definition registers this tool with the MCP platform
+func (*GetCheckoutTool) Definition() mcp.Tool {
+	return mcp.NewTool(
+		constants.GetCheckoutToolName,
+		mcp.WithDescription(constants.GetCheckoutToolDesc),
+		mcp.WithOutputSchema[types.CheckoutLookup](),
+		mcp.WithString(constants.CheckoutIDField, mcp.Description(constants.CheckoutIDDesc)),
+		mcp.WithString(constants.CheckoutReferenceField, mcp.Description(constants.CheckoutReferenceDesc)),
+	)
+}
+
+// Handle processes the tool request and returns a result
+func (t *GetCheckoutTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+
+	id, err := utils.OptionalString(t.logger, args, constants.CheckoutIDField)
+	if err != nil {
+		return nil, err
+	}
+
+	reference, err := utils.OptionalString(t.logger, args, constants.CheckoutReferenceField)
+	if err != nil {
+		return nil, err
+	}
+
+	var checkouts []types.Checkout
+
+	switch {
+	case id != "":
+		checkout, err := t.client.GetCheckout(ctx, id)
+		if err != nil {
+			t.logger.Error("checkout API call failed", slog.String("error", err.Error()))
+			return nil, fmt.Errorf("GetCheckout failed: %w", err)
+		}
+
+		checkouts = append(checkouts, *checkout)
+
+	case reference != "":
+		list, err := t.client.ListCheckouts(ctx, types.ListCheckoutsParams{
+			ListParams:  types.ListParams{Limit: constants.DefaultListLimit},
+			ReferenceID: reference,
+		})
+		if err != nil {
+			t.logger.Error("checkout API call failed", slog.String("error", err.Error()))
+			return nil, fmt.Errorf("ListCheckouts failed: %w", err)
+		}
+
+		if len(list.Data) == 0 {
+			return nil, fmt.Errorf("%w with reference %q", constants.ErrCheckoutNotFound, reference)
+		}
+
+		checkouts = list.Data
+
+	default:
+		return nil, constants.ErrMissingCheckoutLookup
+	}
+
+	// A reference is not necessarily unique, so every matching checkout is reported
+	parts := make([]string, 0, len(checkouts))
+	for i := range checkouts {
+		utils.ExplainDeclines(checkouts[i].PaymentAttempts)
+		parts = append(parts, utils.FormatCheckout(&checkouts[i]))
+	}
+
+	return utils.StructuredResult(t.client.Environment(), &types.CheckoutLookup{Checkouts: checkouts}, strings.Join(parts, "\n\n")), nil
+}
@@ -0,0 +1,68 @@
+package tazapay
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// GetCustomerTool defines the tool structure
+type GetCustomerTool struct {
+	logger *slog.Logger
+	client *api.Client
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.GetCustomerToolName,
+		Category: constants.CategoryCustomers,
+		ReadOnly: true,
+		Scope:    constants.ScopePaymentsRead,
+	}, func(deps registry.Deps) types.Tool {
+		return NewGetCustomerTool(deps.Logger, deps.Client)
+	})
+}
+
+// NewGetCustomerTool returns a new instance of the GetCustomerTool
+func NewGetCustomerTool(logger *slog.Logger, client *api.Client) *GetCustomerTool {
+	logger.Info("Initializing GetCustomerTool")
+
+	return &GetCustomerTool{
+		logger: logger,
+		client: client,
+	}
+}
+
+// Definition registers this tool with the MCP platform
+func (*GetCustomerTool) Definition() mcp.Tool {
+	return mcp.NewTool(
+		constants.GetCustomerToolName,
+		mcp.WithDescription(constants.GetCustomerToolDesc),
+		mcp.WithOutputSchema[types.Customer](),
+		mcp.WithString(constants.CustomerIDField, mcp.Required(), mcp.Description(constants.CustomerIDDesc)),
+	)
+}
+
+// Handle processes the tool request and returns a result
+func (t *GetCustomerTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, err := utils.RequiredString(t.logger, req.GetArguments(), constants.CustomerIDField)
+	if err != nil {
+		return nil, err
+	}
+
+	customer, err := t.client.GetCustomer(ctx, id)
+	if err != nil {
+		t.logger.Error("customer API call failed", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("GetCustomer failed: %w", err)
+	}
+
+	return utils.StructuredResult(t.client.Environment(), customer, utils.FormatCustomer(customer)), nil
+}
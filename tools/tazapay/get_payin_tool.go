@@ -0,0 +1,73 @@
+package tazapay
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// GetPayinTool defines the tool structure
+type GetPayinTool struct {
+	logger *slog.Logger
+	client *api.Client
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.GetPayinToolName,
+		Category: constants.CategoryPayins,
+		ReadOnly: true,
+		Scope:    constants.ScopePaymentsRead,
+	}, func(deps registry.Deps) types.Tool {
+		return NewGetPayinTool(deps.Logger, deps.Client)
+	})
+}
+
+// NewGetPayinTool returns a new instance of the GetPayinTool
+func NewGetPayinTool(logger *slog.Logger, client *api.Client) *GetPayinTool {
+	logger.Info("Initializing GetPayinTool")
+
+	return &GetPayinTool{
+		logger: logger,
+		client: client,
+	}
+}
+
+// Definition registers this tool with the MCP platform
+func (*GetPayinTool) Definition() mcp.Tool {
+	return mcp.NewTool(
+		constants.GetPayinToolName,
+		mcp.WithDescription(constants.GetPayinToolDesc),
+		mcp.WithOutputSchema[types.Payin](),
+		mcp.WithString(constants.PayinIDField, mcp.Required(), mcp.Description(constants.PayinIDDesc)),
+	)
+}
+
+// Handle processes the tool request and returns a result
+func (t *GetPayinTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, err := utils.RequiredString(t.logger, req.GetArguments(), constants.PayinIDField)
+	if err != nil {
+		return nil, err
+	}
+
+	payin, err := t.client.GetPayin(ctx, id)
+	if err != nil {
+		t.logger.Error("payin API call failed", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("GetPayin failed: %w", err)
+	}
+
+	text := utils.FormatPayin(payin)
+	if payin.LatestPaymentAttempt != "" {
+		text += fmt.Sprintf("\nUse %s to see why attempts failed.", constants.ListPaymentAttemptsToolName)
+	}
+
+	return utils.StructuredResult(t.client.Environment(), payin, text), nil
+}
@@ -0,0 +1,68 @@
+package tazapay
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// GetPayoutTool defines the tool structure
+type GetPayoutTool struct {
+	logger *slog.Logger
+	client *api.Client
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.GetPayoutToolName,
+		Category: constants.CategoryPayouts,
+		ReadOnly: true,
+		Scope:    constants.ScopePayoutsRead,
+	}, func(deps registry.Deps) types.Tool {
+		return NewGetPayoutTool(deps.Logger, deps.Client)
+	})
+}
+
+// NewGetPayoutTool returns a new instance of the GetPayoutTool
+func NewGetPayoutTool(logger *slog.Logger, client *api.Client) *GetPayoutTool {
+	logger.Info("Initializing GetPayoutTool")
+
+	return &GetPayoutTool{
+		logger: logger,
+		client: client,
+	}
+}
+
+// Definition registers this tool with the MCP platform
+func (*GetPayoutTool) Definition() mcp.Tool {
+	return mcp.NewTool(
+		constants.GetPayoutToolName,
+		mcp.WithDescription(constants.GetPayoutToolDesc),
+		mcp.WithOutputSchema[types.Payout](),
+		mcp.WithString(constants.PayoutIDField, mcp.Required(), mcp.Description(constants.PayoutIDDesc)),
+	)
+}
+
+// Handle processes the tool request and returns a result
+func (t *GetPayoutTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, err := utils.RequiredString(t.logger, req.GetArguments(), constants.PayoutIDField)
+	if err != nil {
+		return nil, err
+	}
+
+	payout, err := t.client.GetPayout(ctx, id)
+	if err != nil {
+		t.logger.Error("payout API call failed", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("GetPayout failed: %w", err)
+	}
+
+	return utils.StructuredResult(t.client.Environment(), payout, utils.FormatPayout(payout)), nil
+}
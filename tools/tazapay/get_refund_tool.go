@@ -0,0 +1,68 @@
+package tazapay
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// GetRefundTool defines the tool structure
+type GetRefundTool struct {
+	logger *slog.Logger
+	client *api.Client
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.GetRefundToolName,
+		Category: constants.CategoryRefunds,
+		ReadOnly: true,
+		Scope:    constants.ScopePaymentsRead,
+	}, func(deps registry.Deps) types.Tool {
+		return NewGetRefundTool(deps.Logger, deps.Client)
+	})
+}
+
+// NewGetRefundTool returns a new instance of the GetRefundTool
+func NewGetRefundTool(logger *slog.Logger, client *api.Client) *GetRefundTool {
+	logger.Info("Initializing GetRefundTool")
+
+	return &GetRefundTool{
+		logger: logger,
+		client: client,
+	}
+}
+
+// Definition registers this tool with the MCP platform
+func (*GetRefundTool) Definition() mcp.Tool {
+	return mcp.NewTool(
+		constants.GetRefundToolName,
+		mcp.WithDescription(constants.GetRefundToolDesc),
+		mcp.WithOutputSchema[types.Refund](),
+		mcp.WithString(constants.RefundIDField, mcp.Required(), mcp.Description(constants.RefundIDDesc)),
+	)
+}
+
+// Handle processes the tool request and returns a result
+func (t *GetRefundTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, err := utils.RequiredString(t.logger, req.GetArguments(), constants.RefundIDField)
+	if err != nil {
+		return nil, err
+	}
+
+	refund, err := t.client.GetRefund(ctx, id)
+	if err != nil {
+		t.logger.Error("refund API call failed", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("GetRefund failed: %w", err)
+	}
+
+	return utils.StructuredResult(t.client.Environment(), refund, utils.FormatRefund(refund)), nil
+}
@@ -0,0 +1,86 @@
+package tazapay
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// ListBeneficiariesTool defines the tool structure
+type ListBeneficiariesTool struct {
+	logger *slog.Logger
+	client *api.Client
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.ListBeneficiariesToolName,
+		Category: constants.CategoryBeneficiaries,
+		ReadOnly: true,
+		Scope:    constants.ScopePayoutsRead,
+	}, func(deps registry.Deps) types.Tool {
+		return NewListBeneficiariesTool(deps.Logger, deps.Client)
+	})
+}
+
+// NewListBeneficiariesTool returns a new instance of the ListBeneficiariesTool
+func NewListBeneficiariesTool(logger *slog.Logger, client *api.Client) *ListBeneficiariesTool {
+	logger.Info("Initializing ListBeneficiariesTool")
+
+	return &ListBeneficiariesTool{
+		logger: logger,
+		client: client,
+	}
+}
+
+// Definition registers this tool with the MCP platform
+func (*ListBeneficiariesTool) Definition() mcp.Tool {
+	return mcp.NewTool(
+		constants.ListBeneficiariesToolName,
+		mcp.WithDescription(constants.ListBeneficiariesToolDesc),
+		mcp.WithOutputSchema[types.List[types.Beneficiary]](),
+		mcp.WithNumber(constants.LimitField, mcp.Description(constants.LimitDesc)),
+		mcp.WithString(constants.StartingAfterField, mcp.Description(constants.StartingAfterDesc)),
+	)
+}
+
+// Handle processes the tool request and returns a result
+func (t *ListBeneficiariesTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	params, err := utils.ListParamsFromArgs(t.logger, req.GetArguments())
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := t.client.ListBeneficiaries(ctx, params)
+	if err != nil {
+		t.logger.Error("beneficiary API call failed", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("ListBeneficiaries failed: %w", err)
+	}
+
+	var b strings.Builder
+
+	if len(list.Data) == 0 {
+		b.WriteString("No beneficiaries found.")
+	} else {
+		fmt.Fprintf(&b, "%d beneficiary(ies):\n", len(list.Data))
+
+		for i := range list.Data {
+			b.WriteString("- " + utils.FormatBeneficiary(&list.Data[i]) + "\n")
+		}
+
+		if list.HasMore {
+			fmt.Fprintf(&b, "More results available: pass %s=%s", constants.StartingAfterField, list.Data[len(list.Data)-1].ID)
+		}
+	}
+
+	return utils.StructuredResult(t.client.Environment(), list, b.String()), nil
+}
@@ -0,0 +1,97 @@
+package tazapay
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// ListCheckoutsTool defines the tool structure
+type ListCheckoutsTool struct {
+	logger *slog.Logger
+	client *api.Client
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.ListCheckoutsToolName,
+		Category: constants.CategoryCheckouts,
+		ReadOnly: true,
+		Scope:    constants.ScopePaymentsRead,
+	}, func(deps registry.Deps) types.Tool {
+		return NewListCheckoutsTool(deps.Logger, deps.Client)
+	})
+}
+
+// NewListCheckoutsTool returns a new instance of the ListCheckoutsTool
+func NewListCheckoutsTool(logger *slog.Logger, client *api.Client) *ListCheckoutsTool {
+	logger.Info("Initializing ListCheckoutsTool")
+
+	return &ListCheckoutsTool{
+		logger: logger,
+		client: client,
+	}
+}
+
+// Definition registers this tool with the MCP platform
+func (*ListCheckoutsTool) Definition() mcp.Tool {
+	return mcp.NewTool(
+		constants.ListCheckoutsToolName,
+		mcp.WithDescription(constants.ListCheckoutsToolDesc),
+		mcp.WithOutputSchema[types.List[types.Checkout]](),
+		mcp.WithString(constants.CheckoutReferenceField, mcp.Description("Only list checkouts with this reference")),
+		mcp.WithNumber(constants.LimitField, mcp.Description(constants.LimitDesc)),
+		mcp.WithString(constants.StartingAfterField, mcp.Description(constants.StartingAfterDesc)),
+	)
+}
+
+// Handle processes the tool request and returns a result
+func (t *ListCheckoutsTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+
+	var (
+		params types.ListCheckoutsParams
+		err    error
+	)
+
+	if params.ListParams, err = utils.ListParamsFromArgs(t.logger, args); err != nil {
+		return nil, err
+	}
+
+	if params.ReferenceID, err = utils.OptionalString(t.logger, args, constants.CheckoutReferenceField); err != nil {
+		return nil, err
+	}
+
+	list, err := t.client.ListCheckouts(ctx, params)
+	if err != nil {
+		t.logger.Error("checkout API call failed", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("ListCheckouts failed: %w", err)
+	}
+
+	var b strings.Builder
+
+	if len(list.Data) == 0 {
+		b.WriteString("No checkouts found.")
+	} else {
+		fmt.Fprintf(&b, "%d checkout(s):\n", len(list.Data))
+
+		for i := range list.Data {
+			b.WriteString("- " + utils.FormatCheckoutLine(&list.Data[i]) + "\n")
+		}
+
+		if list.HasMore {
+			fmt.Fprintf(&b, "More results available: pass %s=%s", constants.StartingAfterField, list.Data[len(list.Data)-1].ID)
+		}
+	}
+
+	return utils.StructuredResult(t.client.Environment(), list, b.String()), nil
+}
@@ -0,0 +1,149 @@
+package tazapay
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/auth"
+	"github.com/tazapay/tazapay-mcp-server/pkg/store"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/pkg/validation"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// objectKinds are the kinds of objects kept in the store.
+var objectKinds = []string{
+	constants.ObjectCheckout, constants.ObjectRefund, constants.ObjectPayout,
+	constants.ObjectBeneficiary, constants.ObjectCustomer, constants.ObjectFXQuote,
+}
+
+// ListCreatedObjectsTool defines the tool structure
+type ListCreatedObjectsTool struct {
+	logger *slog.Logger
+	client *api.Client
+	store  store.Store
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.ListCreatedObjectsToolName,
+		Category: constants.CategoryHistory,
+		ReadOnly: true,
+		Scope:    constants.ScopePaymentsRead,
+	}, func(deps registry.Deps) types.Tool {
+		if deps.Store == nil {
+			return nil
+		}
+
+		return NewListCreatedObjectsTool(deps.Logger, deps.Client, deps.Store)
+	})
+}
+
+// NewListCreatedObjectsTool returns a new instance of the ListCreatedObjectsTool
+func NewListCreatedObjectsTool(logger *slog.Logger, client *api.Client, s store.Store) *ListCreatedObjectsTool {
+	logger.Info("Initializing ListCreatedObjectsTool")
+
+	return &ListCreatedObjectsTool{
+		logger: logger,
+		client: client,
+		store:  s,
+	}
+}
+
+// Definition registers this tool with the MCP platform
+func (*ListCreatedObjectsTool) Definition() mcp.Tool {
+	return mcp.NewTool(
+		constants.ListCreatedObjectsToolName,
+		mcp.WithDescription(constants.ListCreatedObjectsToolDesc),
+		mcp.WithOutputSchema[types.StoredObjectList](),
+		mcp.WithString(constants.ObjectKindField, mcp.Description(constants.ObjectKindDesc), mcp.Enum(objectKinds...)),
+		mcp.WithString(constants.SinceField, mcp.Description(constants.SinceDesc)),
+		mcp.WithString(constants.UntilField, mcp.Description(constants.UntilDesc)),
+		mcp.WithNumber(constants.LimitField, mcp.Description(constants.LimitDesc)),
+	)
+}
+
+// Handle processes the tool request and returns a result
+func (t *ListCreatedObjectsTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+
+	var (
+		since, until string
+		err          error
+	)
+
+	// Objects of the other environment are not visible, like on the Tazapay side
+	query := store.ObjectQuery{Environment: t.client.Environment().Name}
+
+	// On the shared transports, callers only see their own records, and only
+	// those of tools their scopes let them read
+	if p, ok := auth.PrincipalFromContext(ctx); ok {
+		query.Principal, query.ByPrincipal, query.Tools = p.Subject, true, registry.Readable(p)
+	}
+
+	if query.Kind, err = utils.OptionalString(t.logger, args, constants.ObjectKindField); err != nil {
+		return nil, err
+	}
+
+	if since, err = utils.OptionalString(t.logger, args, constants.SinceField); err != nil {
+		return nil, err
+	}
+
+	if until, err = utils.OptionalString(t.logger, args, constants.UntilField); err != nil {
+		return nil, err
+	}
+
+	list, err := utils.ListParamsFromArgs(t.logger, args)
+	if err != nil {
+		return nil, err
+	}
+
+	v := validation.New()
+
+	query.Limit = list.Limit
+	query.Since = v.OptionalTime(constants.SinceField, since)
+	query.Until = v.OptionalTime(constants.UntilField, until)
+
+	query.Kind = strings.ToLower(strings.TrimSpace(query.Kind))
+	if query.Kind != "" && !slices.Contains(objectKinds, query.Kind) {
+		v.Add(constants.ObjectKindField, "must be one of "+strings.Join(objectKinds, ", "))
+	}
+
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	objects, err := t.store.Objects(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+
+	if len(objects) == 0 {
+		b.WriteString("No matching objects were created through this server.")
+	} else {
+		fmt.Fprintf(&b, "%d object(s), newest first:\n", len(objects))
+
+		for _, o := range objects {
+			fmt.Fprintf(&b, "- %s %s %s via %s", o.CreatedAt.Format(time.RFC3339), o.Kind, o.ID, o.Tool)
+
+			if !o.UpdatedAt.Equal(o.CreatedAt) {
+				b.WriteString(", updated " + o.UpdatedAt.Format(time.RFC3339))
+			}
+
+			b.WriteString("\n")
+		}
+	}
+
+	return utils.StructuredResult(t.client.Environment(), types.StoredObjectList{Objects: objects}, b.String()), nil
+}
@@ -0,0 +1,102 @@
+package tazapay
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// ListCustomersTool defines the tool structure
+type ListCustomersTool struct {
+	logger *slog.Logger
+	client *api.Client
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.ListCustomersToolName,
+		Category: constants.CategoryCustomers,
+		ReadOnly: true,
+		Scope:    constants.ScopePaymentsRead,
+	}, func(deps registry.Deps) types.Tool {
+		return NewListCustomersTool(deps.Logger, deps.Client)
+	})
+}
+
+// NewListCustomersTool returns a new instance of the ListCustomersTool
+func NewListCustomersTool(logger *slog.Logger, client *api.Client) *ListCustomersTool {
+	logger.Info("Initializing ListCustomersTool")
+
+	return &ListCustomersTool{
+		logger: logger,
+		client: client,
+	}
+}
+
+// Definition registers this tool with the MCP platform
+func (*ListCustomersTool) Definition() mcp.Tool {
+	return mcp.NewTool(
+		constants.ListCustomersToolName,
+		mcp.WithDescription(constants.ListCustomersToolDesc),
+		mcp.WithOutputSchema[types.List[types.Customer]](),
+		mcp.WithString(constants.CustomerEmailField, mcp.Description(constants.CustomerEmailFilterDesc)),
+		mcp.WithString(constants.CustomerReferenceField, mcp.Description("Only list customers with this reference")),
+		mcp.WithNumber(constants.LimitField, mcp.Description(constants.LimitDesc)),
+		mcp.WithString(constants.StartingAfterField, mcp.Description(constants.StartingAfterDesc)),
+	)
+}
+
+// Handle processes the tool request and returns a result
+func (t *ListCustomersTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+
+	var (
+		params types.ListCustomersParams
+		err    error
+	)
+
+	if params.ListParams, err = utils.ListParamsFromArgs(t.logger, args); err != nil {
+		return nil, err
+	}
+
+	if params.Email, err = utils.OptionalString(t.logger, args, constants.CustomerEmailField); err != nil {
+		return nil, err
+	}
+
+	if params.ReferenceID, err = utils.OptionalString(t.logger, args, constants.CustomerReferenceField); err != nil {
+		return nil, err
+	}
+
+	list, err := t.client.ListCustomers(ctx, params)
+	if err != nil {
+		t.logger.Error("customer API call failed", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("ListCustomers failed: %w", err)
+	}
+
+	var b strings.Builder
+
+	if len(list.Data) == 0 {
+		b.WriteString("No customers found.")
+	} else {
+		fmt.Fprintf(&b, "%d customer(s):\n", len(list.Data))
+
+		for i := range list.Data {
+			b.WriteString("- " + utils.FormatCustomer(&list.Data[i]) + "\n")
+		}
+
+		if list.HasMore {
+			fmt.Fprintf(&b, "More results available: pass %s=%s", constants.StartingAfterField, list.Data[len(list.Data)-1].ID)
+		}
+	}
+
+	return utils.StructuredResult(t.client.Environment(), list, b.String()), nil
+}
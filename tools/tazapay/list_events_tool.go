@@ -0,0 +1,128 @@
+package tazapay
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/store"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/pkg/validation"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// ListEventsTool defines the tool structure
+type ListEventsTool struct {
+	logger *slog.Logger
+	client *api.Client
+	events store.EventStore
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.ListEventsToolName,
+		Category: constants.CategoryEvents,
+		ReadOnly: true,
+		Scope:    constants.ScopePaymentsRead,
+	}, func(deps registry.Deps) types.Tool {
+		// Without the webhook receiver there are no events to list
+		if deps.Events == nil {
+			return nil
+		}
+
+		return NewListEventsTool(deps.Logger, deps.Client, deps.Events)
+	})
+}
+
+// NewListEventsTool returns a new instance of the ListEventsTool
+func NewListEventsTool(logger *slog.Logger, client *api.Client, events store.EventStore) *ListEventsTool {
+	logger.Info("Initializing ListEventsTool")
+
+	return &ListEventsTool{
+		logger: logger,
+		client: client,
+		events: events,
+	}
+}
+
+// Definition registers this tool with the MCP platform
+func (*ListEventsTool) Definition() mcp.Tool {
+	return mcp.NewTool(
+		constants.ListEventsToolName,
+		mcp.WithDescription(constants.ListEventsToolDesc),
+		mcp.WithOutputSchema[types.EventList](),
+		mcp.WithString(constants.EventTypeField, mcp.Description(constants.EventTypeDesc)),
+		mcp.WithString(constants.EventObjectField, mcp.Description(constants.EventObjectDesc)),
+		mcp.WithString(constants.SinceField, mcp.Description(constants.SinceDesc)),
+		mcp.WithNumber(constants.LimitField, mcp.Description(constants.LimitDesc)),
+	)
+}
+
+// Handle processes the tool request and returns a result
+func (t *ListEventsTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+
+	var (
+		query = store.EventQuery{Environment: t.client.Environment().Name}
+		since string
+		err   error
+	)
+
+	if query.Type, err = utils.OptionalString(t.logger, args, constants.EventTypeField); err != nil {
+		return nil, err
+	}
+
+	if query.ObjectID, err = utils.OptionalString(t.logger, args, constants.EventObjectField); err != nil {
+		return nil, err
+	}
+
+	if since, err = utils.OptionalString(t.logger, args, constants.SinceField); err != nil {
+		return nil, err
+	}
+
+	list, err := utils.ListParamsFromArgs(t.logger, args)
+	if err != nil {
+		return nil, err
+	}
+
+	query.Limit = list.Limit
+	query.Type = strings.ToLower(strings.TrimSpace(query.Type))
+	query.ObjectID = strings.TrimSpace(query.ObjectID)
+
+	v := validation.New()
+	if query.Since = v.OptionalTime(constants.SinceField, since); v.Err() != nil {
+		return nil, v.Err()
+	}
+
+	events, err := t.events.Events(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+
+	if len(events) == 0 {
+		b.WriteString("No matching webhook events received.")
+	} else {
+		fmt.Fprintf(&b, "%d event(s), newest first:\n", len(events))
+
+		for _, e := range events {
+			fmt.Fprintf(&b, "- %s %s", e.ReceivedAt.Format(time.RFC3339), e.Type)
+
+			if e.ObjectID != "" {
+				b.WriteString(" for " + e.ObjectID)
+			}
+
+			b.WriteString(" (" + e.ID + ")\n")
+		}
+	}
+
+	return utils.StructuredResult(t.client.Environment(), types.EventList{Events: events}, b.String()), nil
+}
@@ -0,0 +1,99 @@
+package tazapay
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// ListPaymentAttemptsTool defines the tool structure
+type ListPaymentAttemptsTool struct {
+	logger *slog.Logger
+	client *api.Client
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.ListPaymentAttemptsToolName,
+		Category: constants.CategoryPayins,
+		ReadOnly: true,
+		Scope:    constants.ScopePaymentsRead,
+	}, func(deps registry.Deps) types.Tool {
+		return NewListPaymentAttemptsTool(deps.Logger, deps.Client)
+	})
+}
+
+// NewListPaymentAttemptsTool returns a new instance of the ListPaymentAttemptsTool
+func NewListPaymentAttemptsTool(logger *slog.Logger, client *api.Client) *ListPaymentAttemptsTool {
+	logger.Info("Initializing ListPaymentAttemptsTool")
+
+	return &ListPaymentAttemptsTool{
+		logger: logger,
+		client: client,
+	}
+}
+
+// Definition registers this tool with the MCP platform
+func (*ListPaymentAttemptsTool) Definition() mcp.Tool {
+	return mcp.NewTool(
+		constants.ListPaymentAttemptsToolName,
+		mcp.WithDescription(constants.ListPaymentAttemptsToolDesc),
+		mcp.WithOutputSchema[types.List[types.PaymentAttempt]](),
+		mcp.WithString(constants.PayinIDField, mcp.Required(), mcp.Description(constants.PayinIDDesc)),
+		mcp.WithNumber(constants.LimitField, mcp.Description(constants.LimitDesc)),
+		mcp.WithString(constants.StartingAfterField, mcp.Description(constants.StartingAfterDesc)),
+	)
+}
+
+// Handle processes the tool request and returns a result
+func (t *ListPaymentAttemptsTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+
+	var (
+		params types.ListPaymentAttemptsParams
+		err    error
+	)
+
+	if params.Payin, err = utils.RequiredString(t.logger, args, constants.PayinIDField); err != nil {
+		return nil, err
+	}
+
+	if params.ListParams, err = utils.ListParamsFromArgs(t.logger, args); err != nil {
+		return nil, err
+	}
+
+	list, err := t.client.ListPaymentAttempts(ctx, params)
+	if err != nil {
+		t.logger.Error("payment attempt API call failed", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("ListPaymentAttempts failed: %w", err)
+	}
+
+	utils.ExplainDeclines(list.Data)
+
+	var b strings.Builder
+
+	if len(list.Data) == 0 {
+		fmt.Fprintf(&b, "Payin %s has no payment attempts.", params.Payin)
+	} else {
+		fmt.Fprintf(&b, "%d payment attempt(s) for payin %s:\n", len(list.Data), params.Payin)
+
+		for i := range list.Data {
+			b.WriteString("- " + utils.FormatPaymentAttempt(&list.Data[i]) + "\n")
+		}
+
+		if list.HasMore {
+			fmt.Fprintf(&b, "More results available: pass %s=%s", constants.StartingAfterField, list.Data[len(list.Data)-1].ID)
+		}
+	}
+
+	return utils.StructuredResult(t.client.Environment(), list, b.String()), nil
+}
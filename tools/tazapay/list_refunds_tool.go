@@ -0,0 +1,97 @@
+package tazapay
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// ListRefundsTool defines the tool structure
+type ListRefundsTool struct {
+	logger *slog.Logger
+	client *api.Client
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.ListRefundsToolName,
+		Category: constants.CategoryRefunds,
+		ReadOnly: true,
+		Scope:    constants.ScopePaymentsRead,
+	}, func(deps registry.Deps) types.Tool {
+		return NewListRefundsTool(deps.Logger, deps.Client)
+	})
+}
+
+// NewListRefundsTool returns a new instance of the ListRefundsTool
+func NewListRefundsTool(logger *slog.Logger, client *api.Client) *ListRefundsTool {
+	logger.Info("Initializing ListRefundsTool")
+
+	return &ListRefundsTool{
+		logger: logger,
+		client: client,
+	}
+}
+
+// Definition registers this tool with the MCP platform
+func (*ListRefundsTool) Definition() mcp.Tool {
+	return mcp.NewTool(
+		constants.ListRefundsToolName,
+		mcp.WithDescription(constants.ListRefundsToolDesc),
+		mcp.WithOutputSchema[types.List[types.Refund]](),
+		mcp.WithString(constants.RefundPayinField, mcp.Description("Only list refunds of this payin")),
+		mcp.WithNumber(constants.LimitField, mcp.Description(constants.LimitDesc)),
+		mcp.WithString(constants.StartingAfterField, mcp.Description(constants.StartingAfterDesc)),
+	)
+}
+
+// Handle processes the tool request and returns a result
+func (t *ListRefundsTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+
+	var (
+		params types.ListRefundsParams
+		err    error
+	)
+
+	if params.ListParams, err = utils.ListParamsFromArgs(t.logger, args); err != nil {
+		return nil, err
+	}
+
+	if params.Payin, err = utils.OptionalString(t.logger, args, constants.RefundPayinField); err != nil {
+		return nil, err
+	}
+
+	list, err := t.client.ListRefunds(ctx, params)
+	if err != nil {
+		t.logger.Error("refund API call failed", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("ListRefunds failed: %w", err)
+	}
+
+	var b strings.Builder
+
+	if len(list.Data) == 0 {
+		b.WriteString("No refunds found.")
+	} else {
+		fmt.Fprintf(&b, "%d refund(s):\n", len(list.Data))
+
+		for i := range list.Data {
+			b.WriteString("- " + utils.FormatRefund(&list.Data[i]) + "\n")
+		}
+
+		if list.HasMore {
+			fmt.Fprintf(&b, "More results available: pass %s=%s", constants.StartingAfterField, list.Data[len(list.Data)-1].ID)
+		}
+	}
+
+	return utils.StructuredResult(t.client.Environment(), list, b.String()), nil
+}
@@ -0,0 +1,145 @@
+package tazapay
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/auth"
+	"github.com/tazapay/tazapay-mcp-server/pkg/store"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/pkg/validation"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// ListToolCallsTool defines the tool structure
+type ListToolCallsTool struct {
+	logger *slog.Logger
+	client *api.Client
+	store  store.Store
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.ListToolCallsToolName,
+		Category: constants.CategoryHistory,
+		ReadOnly: true,
+		Scope:    constants.ScopePaymentsRead,
+	}, func(deps registry.Deps) types.Tool {
+		if deps.Store == nil {
+			return nil
+		}
+
+		return NewListToolCallsTool(deps.Logger, deps.Client, deps.Store)
+	})
+}
+
+// NewListToolCallsTool returns a new instance of the ListToolCallsTool
+func NewListToolCallsTool(logger *slog.Logger, client *api.Client, s store.Store) *ListToolCallsTool {
+	logger.Info("Initializing ListToolCallsTool")
+
+	return &ListToolCallsTool{
+		logger: logger,
+		client: client,
+		store:  s,
+	}
+}
+
+// Definition registers this tool with the MCP platform
+func (*ListToolCallsTool) Definition() mcp.Tool {
+	return mcp.NewTool(
+		constants.ListToolCallsToolName,
+		mcp.WithDescription(constants.ListToolCallsToolDesc),
+		mcp.WithOutputSchema[types.ToolCallList](),
+		mcp.WithString(constants.ToolNameField, mcp.Description(constants.ToolNameDesc)),
+		mcp.WithString(constants.SinceField, mcp.Description(constants.SinceDesc)),
+		mcp.WithString(constants.UntilField, mcp.Description(constants.UntilDesc)),
+		mcp.WithNumber(constants.LimitField, mcp.Description(constants.LimitDesc)),
+	)
+}
+
+// Handle processes the tool request and returns a result
+func (t *ListToolCallsTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+
+	var (
+		since, until string
+		err          error
+	)
+
+	query := store.CallQuery{Environment: t.client.Environment().Name}
+
+	// On the shared transports, callers only see their own records, and only
+	// those of tools their scopes let them read
+	if p, ok := auth.PrincipalFromContext(ctx); ok {
+		query.Principal, query.ByPrincipal, query.Tools = p.Subject, true, registry.Readable(p)
+	}
+
+	if query.Tool, err = utils.OptionalString(t.logger, args, constants.ToolNameField); err != nil {
+		return nil, err
+	}
+
+	if since, err = utils.OptionalString(t.logger, args, constants.SinceField); err != nil {
+		return nil, err
+	}
+
+	if until, err = utils.OptionalString(t.logger, args, constants.UntilField); err != nil {
+		return nil, err
+	}
+
+	list, err := utils.ListParamsFromArgs(t.logger, args)
+	if err != nil {
+		return nil, err
+	}
+
+	v := validation.New()
+
+	query.Tool = strings.TrimSpace(query.Tool)
+	query.Limit = list.Limit
+	query.Since = v.OptionalTime(constants.SinceField, since)
+	query.Until = v.OptionalTime(constants.UntilField, until)
+
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	calls, err := t.store.Calls(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+
+	if len(calls) == 0 {
+		b.WriteString("No matching tool calls recorded.")
+	} else {
+		fmt.Fprintf(&b, "%d call(s), newest first:\n", len(calls))
+
+		for _, c := range calls {
+			fmt.Fprintf(&b, "- %s %s: %s", c.StartedAt.Format(time.RFC3339), c.Tool, c.Outcome)
+
+			if c.ErrorCode != "" {
+				b.WriteString(" (" + c.ErrorCode + ")")
+			}
+
+			if c.ObjectID != "" {
+				b.WriteString(", " + c.ObjectID)
+			}
+
+			if c.Principal != "" {
+				b.WriteString(", by " + c.Principal)
+			}
+
+			b.WriteString("\n")
+		}
+	}
+
+	return utils.StructuredResult(t.client.Environment(), types.ToolCallList{Calls: calls}, b.String()), nil
+}
@@ -4,25 +4,47 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"math"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 
 	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/pkg/money"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
 	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/pkg/validation"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
 	"github.com/tazapay/tazapay-mcp-server/types"
 )
 
 // PaymentLinkTool defines the tool structure
 type PaymentLinkTool struct {
 	logger *slog.Logger
+	client *api.Client
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.PaymentLinkToolName,
+		Category: constants.CategoryCheckouts,
+		ReadOnly: false,
+		Scope:    constants.ScopePaymentsWrite,
+		Object:   constants.ObjectCheckout,
+	}, func(deps registry.Deps) types.Tool {
+		return NewPaymentLinkTool(deps.Logger, deps.Client)
+	})
 }
 
 // NewPaymentLinkTool returns a new instance of the PaymentLinkTool
-func NewPaymentLinkTool(logger *slog.Logger) *PaymentLinkTool {
-	logger.Info("Initializing PaymentLinkTool")
+func NewPaymentLinkTool(logger *slog.Logger, client *api.Client) *PaymentLinkTool {
+	logger.Info("Initializing PaymentLinkTool", slog.String("environment", client.Environment().Name))
 
 	return &PaymentLinkTool{
 		logger: logger,
+		client: client,
 	}
 }
 
@@ -31,18 +53,61 @@ func (*PaymentLinkTool) Definition() mcp.Tool {
 	return mcp.NewTool(
 		constants.PaymentLinkToolName,
 		mcp.WithDescription(constants.PaymentLinkToolDesc),
+		mcp.WithOutputSchema[types.Checkout](),
 		mcp.WithString(constants.InvoiceCurrencyField, mcp.Required(), mcp.Description(constants.InvoiceCurrencyDesc)),
 		mcp.WithNumber(constants.PaymentAmountField, mcp.Required(), mcp.Description(constants.PaymentAmountDesc)),
-		mcp.WithString(constants.CustomerNameField, mcp.Required(), mcp.Description(constants.CustomerNameDesc)),
-		mcp.WithString(constants.CustomerEmailField, mcp.Required(), mcp.Description(constants.CustomerEmailDesc)),
-		mcp.WithString(constants.CustomerCountryField, mcp.Required(), mcp.Description(constants.CustomerCountryDesc)),
+		mcp.WithString(constants.CustomerField, mcp.Description(constants.CustomerDesc)),
+		mcp.WithString(constants.CustomerNameField, mcp.Description(constants.CustomerNameDesc)),
+		mcp.WithString(constants.CustomerEmailField, mcp.Description(constants.CustomerEmailDesc)),
+		mcp.WithString(constants.CustomerCountryField, mcp.Description(constants.CustomerCountryDesc)),
 		mcp.WithString(constants.TransactionDescField, mcp.Required(), mcp.Description(constants.TransactionDesc)),
+		mcp.WithString(constants.CheckoutReferenceField, mcp.Description(constants.PaymentLinkReferenceDesc)),
+		mcp.WithString(constants.SuccessURLField, mcp.Description(constants.SuccessURLDesc)),
+		mcp.WithString(constants.CancelURLField, mcp.Description(constants.CancelURLDesc)),
+		mcp.WithString(constants.WebhookURLField, mcp.Description(constants.WebhookURLDesc)),
+		mcp.WithString(constants.ExpiresAtField, mcp.Description(constants.ExpiresAtDesc)),
+		mcp.WithArray(constants.PaymentMethodsField, mcp.Description(constants.PaymentMethodsDesc), mcp.WithStringItems()),
+		mcp.WithArray(constants.ItemsField, mcp.Description(constants.ItemsDesc), mcp.Items(checkoutItemSchema())),
+		mcp.WithObject(constants.ShippingAddressField, mcp.Description(constants.ShippingAddressDesc),
+			mcp.Properties(addressProperties())),
+		mcp.WithObject(constants.BillingAddressField, mcp.Description(constants.BillingAddressDesc),
+			mcp.Properties(addressProperties())),
+		mcp.WithObject(constants.MetadataField, mcp.Description(constants.MetadataDesc),
+			mcp.AdditionalProperties(map[string]any{"type": "string"})),
 	)
 }
 
+// checkoutItemSchema is the JSON schema of a line item
+func checkoutItemSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			constants.ItemNameKey:        map[string]any{"type": "string"},
+			constants.ItemDescriptionKey: map[string]any{"type": "string"},
+			constants.ItemQuantityKey:    map[string]any{"type": "integer", "minimum": 1},
+			constants.ItemAmountKey:      map[string]any{"type": "number"},
+		},
+		"required": []string{constants.ItemNameKey, constants.ItemQuantityKey, constants.ItemAmountKey},
+	}
+}
+
+// addressProperties are the JSON schema properties of an address
+func addressProperties() map[string]any {
+	properties := map[string]any{}
+
+	for _, key := range []string{
+		constants.AddressNameKey, constants.AddressLine1Key, constants.AddressLine2Key, constants.AddressCityKey,
+		constants.AddressStateKey, constants.AddressCountryKey, constants.AddressPostalCodeKey,
+	} {
+		properties[key] = map[string]any{"type": "string"}
+	}
+
+	return properties
+}
+
 // Handle processes the tool request and returns a result
 func (t *PaymentLinkTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	args := req.Params.Arguments
+	args := req.GetArguments()
 
 	t.logger.Info("handling payment link tool request", slog.Any("args", args))
 
@@ -55,35 +120,57 @@ func (t *PaymentLinkTool) Handle(ctx context.Context, req mcp.CallToolRequest) (
 	payload := NewPaymentLinkRequest(&params)
 	t.logger.Info("constructed payment link payload", slog.Any("payload", payload))
 
-	resp, err := utils.HandlePOSTHttpRequest(ctx, t.logger, constants.PaymentLinkBaseURLProd,
-		payload, constants.PostHTTPMethod)
+	checkout, err := t.client.CreateCheckout(ctx, &payload)
 	if err != nil {
 		t.logger.Error("payment link API call failed", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("HandlePOSTHttpRequest failed: %w", err)
+		return nil, fmt.Errorf("CreateCheckout failed: %w", err)
 	}
 
-	data, ok := resp["data"].(map[string]any)
-	if !ok {
-		t.logger.Error("no data found in payment link API response", slog.Any("response", resp))
-		return nil, constants.ErrNoDataInResponse
+	paymentLink := checkout.URL
+	if paymentLink == "" {
+		t.logger.Error("payment link missing in API response", slog.String("checkout_id", checkout.ID))
+		return nil, constants.ErrMissingPaymentLink
 	}
 
-	paymentLink, ok := data["url"].(string)
-	if !ok {
-		t.logger.Error("payment link missing in API response", slog.Any("data", data))
-		return nil, constants.ErrMissingPaymentLink
+	t.logger.Info("payment link successfully generated", slog.String("url", paymentLink), slog.String("checkout_id", checkout.ID))
+
+	return utils.StructuredResult(t.client.Environment(), checkout, fmt.Sprintf(
+		"Payment Link URL: %s\nCheckout ID: %s\nUse %s with this ID to check whether it was paid.",
+		paymentLink, checkout.ID, constants.GetCheckoutToolName,
+	)), nil
+}
+
+// Preview describes the payment link before it is created
+func (t *PaymentLinkTool) Preview(_ context.Context, req mcp.CallToolRequest) (string, error) {
+	p, err := validateAndExtractArgs(t, req.GetArguments())
+	if err != nil {
+		return "", err
 	}
 
-	t.logger.Info("payment link successfully generated", slog.String("url", paymentLink))
+	payer := fmt.Sprintf("%s <%s> (%s)", p.CustomerName, p.CustomerEmail, p.CustomerCountry)
+	if p.Customer != "" {
+		payer = "customer " + p.Customer
+	}
 
-	return &mcp.CallToolResult{
-		Content: []mcp.Content{
-			mcp.TextContent{
-				Type: "text",
-				Text: "Payment Link URL: " + paymentLink,
-			},
-		},
-	}, nil
+	preview := fmt.Sprintf("Create a payment link for %s to %s: %s", p.PaymentAmount.Display(), payer, p.Description)
+
+	if p.ReferenceID != "" {
+		preview += "\nReference: " + p.ReferenceID
+	}
+
+	if p.ExpiresAt != "" {
+		preview += "\nExpires at: " + p.ExpiresAt
+	}
+
+	if len(p.PaymentMethods) > 0 {
+		preview += "\nPayment methods: " + strings.Join(p.PaymentMethods, ", ")
+	}
+
+	if len(p.Items) > 0 {
+		preview += fmt.Sprintf("\nLine items: %d", len(p.Items))
+	}
+
+	return utils.TagEnvironment(t.client.Environment(), preview), nil
 }
 
 // validateAndExtractArgs validates request arguments and returns structured parameters
@@ -91,10 +178,6 @@ func validateAndExtractArgs(t *PaymentLinkTool, args map[string]any) (types.Paym
 	var p types.PaymentLinkParams
 	var ok bool
 
-	if p.PaymentAmount, ok = args[constants.PaymentAmountField].(float64); !ok {
-		return p, utils.WrapFieldTypeError(t.logger, constants.PaymentAmountField)
-	}
-
 	if p.InvoiceCurrency, ok = args[constants.InvoiceCurrencyField].(string); !ok {
 		return p, utils.WrapFieldTypeError(t.logger, constants.InvoiceCurrencyField)
 	}
@@ -103,31 +186,258 @@ func validateAndExtractArgs(t *PaymentLinkTool, args map[string]any) (types.Paym
 		return p, utils.WrapFieldTypeError(t.logger, constants.TransactionDescField)
 	}
 
-	if p.CustomerName, ok = args[constants.CustomerNameField].(string); !ok {
-		return p, utils.WrapFieldTypeError(t.logger, constants.CustomerNameField)
+	v := validation.New()
+
+	p.InvoiceCurrency = v.Currency(constants.InvoiceCurrencyField, p.InvoiceCurrency)
+	p.PaymentAmount = utils.ValidAmount(t.logger, v, args, constants.PaymentAmountField,
+		constants.InvoiceCurrencyField, p.InvoiceCurrency)
+	p.Description = v.Required(constants.TransactionDescField, p.Description)
+
+	if err := extractPayer(t, args, v, &p); err != nil {
+		return p, err
+	}
+
+	if err := extractCheckoutOptions(t, args, v, &p); err != nil {
+		return p, err
+	}
+
+	return p, v.Err()
+}
+
+// extractPayer validates the payer: either an existing customer ID, or the
+// name, email and country of a new customer
+func extractPayer(t *PaymentLinkTool, args map[string]any, v *validation.Validator, p *types.PaymentLinkParams) error {
+	var err error
+
+	for _, field := range []struct {
+		name string
+		dst  *string
+	}{
+		{constants.CustomerField, &p.Customer},
+		{constants.CustomerNameField, &p.CustomerName},
+		{constants.CustomerEmailField, &p.CustomerEmail},
+		{constants.CustomerCountryField, &p.CustomerCountry},
+	} {
+		if *field.dst, err = utils.OptionalString(t.logger, args, field.name); err != nil {
+			return err
+		}
+	}
+
+	if p.Customer = strings.TrimSpace(p.Customer); p.Customer != "" {
+		if p.CustomerName != "" || p.CustomerEmail != "" || p.CustomerCountry != "" {
+			v.Add(constants.CustomerField, fmt.Sprintf("cannot be combined with %s, %s or %s",
+				constants.CustomerNameField, constants.CustomerEmailField, constants.CustomerCountryField))
+		}
+
+		return nil
+	}
+
+	if p.CustomerName == "" && p.CustomerEmail == "" && p.CustomerCountry == "" {
+		return constants.ErrMissingCustomer
+	}
+
+	p.CustomerName = v.Required(constants.CustomerNameField, p.CustomerName)
+	p.CustomerEmail = v.Email(constants.CustomerEmailField, p.CustomerEmail)
+	p.CustomerCountry = v.Country(constants.CustomerCountryField, p.CustomerCountry)
+
+	return nil
+}
+
+// extractCheckoutOptions validates the optional checkout options into p
+func extractCheckoutOptions(t *PaymentLinkTool, args map[string]any, v *validation.Validator,
+	p *types.PaymentLinkParams,
+) error {
+	var err error
+
+	for _, opt := range []struct {
+		field string
+		dst   *string
+	}{
+		{constants.CheckoutReferenceField, &p.ReferenceID},
+		{constants.SuccessURLField, &p.SuccessURL},
+		{constants.CancelURLField, &p.CancelURL},
+		{constants.WebhookURLField, &p.WebhookURL},
+		{constants.ExpiresAtField, &p.ExpiresAt},
+	} {
+		if *opt.dst, err = utils.OptionalString(t.logger, args, opt.field); err != nil {
+			return err
+		}
+	}
+
+	p.ReferenceID = strings.TrimSpace(p.ReferenceID)
+	p.SuccessURL = v.OptionalURL(constants.SuccessURLField, p.SuccessURL)
+	p.CancelURL = v.OptionalURL(constants.CancelURLField, p.CancelURL)
+	p.WebhookURL = v.OptionalURL(constants.WebhookURLField, p.WebhookURL)
+	p.ExpiresAt = v.OptionalFutureTime(constants.ExpiresAtField, p.ExpiresAt, time.Now())
+
+	methods, err := utils.OptionalStringList(t.logger, args, constants.PaymentMethodsField)
+	if err != nil {
+		return err
+	}
+
+	for i, method := range methods {
+		method = strings.ToLower(strings.TrimSpace(method))
+		if method == "" {
+			v.Add(fmt.Sprintf("%s[%d]", constants.PaymentMethodsField, i), "is empty")
+			continue
+		}
+
+		if !slices.Contains(p.PaymentMethods, method) {
+			p.PaymentMethods = append(p.PaymentMethods, method)
+		}
+	}
+
+	if p.Items, err = extractCheckoutItems(t, args, v, p.InvoiceCurrency, p.PaymentAmount); err != nil {
+		return err
+	}
+
+	if p.Shipping, err = extractAddress(t, args, v, constants.ShippingAddressField); err != nil {
+		return err
 	}
 
-	if p.CustomerEmail, ok = args[constants.CustomerEmailField].(string); !ok {
-		return p, utils.WrapFieldTypeError(t.logger, constants.CustomerEmailField)
+	if p.Billing, err = extractAddress(t, args, v, constants.BillingAddressField); err != nil {
+		return err
 	}
 
-	if p.CustomerCountry, ok = args[constants.CustomerCountryField].(string); !ok {
-		return p, utils.WrapFieldTypeError(t.logger, constants.CustomerCountryField)
+	if p.Metadata, err = utils.OptionalStringMap(t.logger, args, constants.MetadataField); err != nil {
+		return err
 	}
 
-	return p, nil
+	if len(p.Metadata) > constants.MaxMetadataKeys {
+		v.Add(constants.MetadataField, fmt.Sprintf("has %d keys; at most %d are allowed",
+			len(p.Metadata), constants.MaxMetadataKeys))
+	}
+
+	for key, value := range p.Metadata {
+		if len(value) > constants.MaxMetadataValueLen {
+			v.Add(constants.MetadataField+"."+key, fmt.Sprintf("is longer than %d characters", constants.MaxMetadataValueLen))
+		}
+	}
+
+	return nil
+}
+
+// extractCheckoutItems validates the line items. Unit prices are in the invoice
+// currency and are only checked once that currency is valid. The checkout page
+// shows the items, so once every item is valid they must add up to total.
+func extractCheckoutItems(t *PaymentLinkTool, args map[string]any, v *validation.Validator, currency string,
+	total money.Money,
+) ([]types.CheckoutItem, error) {
+	list, err := utils.OptionalObjectList(t.logger, args, constants.ItemsField)
+	if err != nil || len(list) == 0 {
+		return nil, err
+	}
+
+	if len(list) > constants.MaxCheckoutItems {
+		v.Add(constants.ItemsField, fmt.Sprintf("has %d items; at most %d are allowed", len(list), constants.MaxCheckoutItems))
+	}
+
+	items := make([]types.CheckoutItem, 0, len(list))
+	priced := v.Valid(constants.InvoiceCurrencyField) // Every item has a valid quantity and unit price
+	sum := int64(0)
+
+	for i, raw := range list {
+		prefix := fmt.Sprintf("%s[%d].", constants.ItemsField, i)
+
+		name, _ := raw[constants.ItemNameKey].(string)
+		description, _ := raw[constants.ItemDescriptionKey].(string)
+
+		quantity, ok := raw[constants.ItemQuantityKey].(float64)
+		if !ok || quantity < 1 || quantity > constants.MaxAmountMinorUnits || quantity != math.Trunc(quantity) {
+			v.Add(prefix+constants.ItemQuantityKey,
+				fmt.Sprintf("must be a whole number from 1 to %d", int64(constants.MaxAmountMinorUnits)))
+			priced = false
+		}
+
+		item := types.CheckoutItem{
+			Name:        v.Required(prefix+constants.ItemNameKey, name),
+			Description: strings.TrimSpace(description),
+			Quantity:    int(quantity),
+		}
+
+		if v.Valid(constants.InvoiceCurrencyField) {
+			amount, err := utils.OptionalAmount(t.logger, raw, constants.ItemAmountKey, currency)
+			if err != nil {
+				v.Add(prefix+constants.ItemAmountKey, strings.TrimPrefix(err.Error(), constants.ItemAmountKey+": "))
+			} else {
+				v.Amount(prefix+constants.ItemAmountKey, amount)
+			}
+
+			item.Amount = amount.Minor
+
+			// Line totals are bounded like amounts, so neither they nor the sum overflow
+			if v.Valid(prefix+constants.ItemQuantityKey) && v.Valid(prefix+constants.ItemAmountKey) {
+				if item.Amount > constants.MaxAmountMinorUnits/int64(item.Quantity) {
+					v.Add(prefix+constants.ItemAmountKey, "times quantity must not exceed "+
+						money.FromMinor(constants.MaxAmountMinorUnits, currency).Display())
+				} else {
+					sum += item.Amount * int64(item.Quantity)
+				}
+			}
+
+			priced = priced && v.Valid(prefix+constants.ItemAmountKey)
+		}
+
+		items = append(items, item)
+	}
+
+	if priced && v.Valid(constants.PaymentAmountField) && sum != total.Minor {
+		v.Add(constants.ItemsField, fmt.Sprintf("add up to %s but %s is %s", money.FromMinor(sum, currency).Display(),
+			constants.PaymentAmountField, total.Display()))
+	}
+
+	return items, nil
+}
+
+// extractAddress validates a shipping or billing address argument
+func extractAddress(t *PaymentLinkTool, args map[string]any, v *validation.Validator, field string,
+) (*types.AddressDetails, error) {
+	raw, err := utils.OptionalStringMap(t.logger, args, field)
+	if err != nil || raw == nil {
+		return nil, err
+	}
+
+	prefix := field + "."
+
+	return &types.AddressDetails{
+		Name: strings.TrimSpace(raw[constants.AddressNameKey]),
+		Address: types.Address{
+			Line1:      v.Required(prefix+constants.AddressLine1Key, raw[constants.AddressLine1Key]),
+			Line2:      strings.TrimSpace(raw[constants.AddressLine2Key]),
+			City:       v.Required(prefix+constants.AddressCityKey, raw[constants.AddressCityKey]),
+			State:      strings.TrimSpace(raw[constants.AddressStateKey]),
+			Country:    v.Country(prefix+constants.AddressCountryKey, raw[constants.AddressCountryKey]),
+			PostalCode: strings.TrimSpace(raw[constants.AddressPostalCodeKey]),
+		},
+	}, nil
 }
 
 // NewPaymentLinkRequest constructs the API payload from the validated parameters
 func NewPaymentLinkRequest(p *types.PaymentLinkParams) types.PaymentLinkRequest {
-	return types.PaymentLinkRequest{
-		Amount:                 int64(p.PaymentAmount * constants.Num100),
-		InvoiceCurrency:        p.InvoiceCurrency,
+	req := types.PaymentLinkRequest{
+		Amount:                 p.PaymentAmount.Minor,
+		InvoiceCurrency:        p.PaymentAmount.Currency,
 		TransactionDescription: p.Description,
-		CustomerDetails: map[string]string{
+		Customer:               p.Customer,
+		ReferenceID:            p.ReferenceID,
+		SuccessURL:             p.SuccessURL,
+		CancelURL:              p.CancelURL,
+		WebhookURL:             p.WebhookURL,
+		ExpiresAt:              p.ExpiresAt,
+		PaymentMethods:         p.PaymentMethods,
+		Items:                  p.Items,
+		ShippingDetails:        p.Shipping,
+		BillingDetails:         p.Billing,
+		Metadata:               p.Metadata,
+	}
+
+	if p.Customer == "" {
+		req.CustomerDetails = map[string]string{
 			"name":    p.CustomerName,
 			"email":   p.CustomerEmail,
 			"country": p.CustomerCountry,
-		},
+		}
 	}
+
+	return req
 }
@@ -0,0 +1,110 @@
+package tazapay_test
+
+import (
+	"testing"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	"github.com/tazapay/tazapay-mcp-server/tools/tazapay"
+)
+
+func TestPaymentLinkArguments(t *testing.T) {
+	tool := tazapay.NewPaymentLinkTool(discard, newTestClient(t, nil))
+
+	valid := map[string]any{
+		constants.InvoiceCurrencyField: "USD",
+		constants.PaymentAmountField:   25.5,
+		constants.TransactionDescField: "Order 42",
+		constants.CustomerNameField:    "Ada Lovelace",
+		constants.CustomerEmailField:   "ada@example.com",
+		constants.CustomerCountryField: "GB",
+	}
+
+	item := func(quantity, amount float64) map[string]any {
+		return map[string]any{
+			constants.ItemNameKey: "Widget", constants.ItemQuantityKey: quantity, constants.ItemAmountKey: amount,
+		}
+	}
+
+	runCases(t, []argsCase{
+		{name: "new customer", args: valid},
+		{
+			name: "existing customer",
+			args: with(valid, constants.CustomerNameField, nil, constants.CustomerEmailField, nil,
+				constants.CustomerCountryField, nil, constants.CustomerField, "cus_1"),
+		},
+		{
+			name: "customer and customer details",
+			args: with(valid, constants.CustomerField, "cus_1"),
+			want: constants.CustomerField + ": cannot be combined",
+		},
+		{
+			name: "no payer",
+			args: with(valid, constants.CustomerNameField, nil, constants.CustomerEmailField, nil,
+				constants.CustomerCountryField, nil),
+			wantErr: constants.ErrMissingCustomer,
+		},
+		{
+			name:    "invalid email",
+			args:    with(valid, constants.CustomerEmailField, "ada@"),
+			wantErr: constants.ErrInvalidArguments,
+			want:    constants.CustomerEmailField,
+		},
+		{
+			name:    "ambiguous currency",
+			args:    with(valid, constants.InvoiceCurrencyField, "dollars"),
+			wantErr: constants.ErrInvalidArguments,
+			want:    constants.InvoiceCurrencyField,
+		},
+		{
+			name:    "zero amount",
+			args:    with(valid, constants.PaymentAmountField, 0.0),
+			wantErr: constants.ErrInvalidArguments,
+			want:    constants.PaymentAmountField + ": must be greater than zero",
+		},
+		{
+			name: "items adding up to the amount",
+			args: with(valid, constants.ItemsField, []any{item(2, 10), item(1, 5.5)}),
+		},
+		{
+			name:    "items not adding up to the amount",
+			args:    with(valid, constants.ItemsField, []any{item(2, 10), item(1, 5)}),
+			wantErr: constants.ErrInvalidArguments,
+			want:    constants.ItemsField + ": add up to USD 25.00 but payment_amount is USD 25.50",
+		},
+		{
+			name:    "item with a fractional quantity",
+			args:    with(valid, constants.ItemsField, []any{item(1.5, 17)}),
+			wantErr: constants.ErrInvalidArguments,
+			want:    "items[0].quantity",
+		},
+		{
+			name:    "item with a quantity beyond the amount limit",
+			args:    with(valid, constants.ItemsField, []any{item(1e19, 25.5)}),
+			wantErr: constants.ErrInvalidArguments,
+			want:    "items[0].quantity: must be a whole number from 1 to",
+		},
+		{
+			name:    "item with a line total beyond the amount limit",
+			args:    with(valid, constants.ItemsField, []any{item(1e12, 25.5)}),
+			wantErr: constants.ErrInvalidArguments,
+			want:    "items[0].amount: times quantity must not exceed",
+		},
+		{
+			name:    "item with more decimals than the currency",
+			args:    with(valid, constants.ItemsField, []any{item(1, 25.505)}),
+			wantErr: constants.ErrInvalidArguments,
+			want:    "items[0].amount",
+		},
+		{
+			name: "incomplete address",
+			args: with(valid, constants.ShippingAddressField, map[string]any{
+				constants.AddressLine1Key: "12 St James's Square", constants.AddressCountryKey: "GB",
+			}),
+			wantErr: constants.ErrInvalidArguments,
+			want:    constants.ShippingAddressField + "." + constants.AddressCityKey,
+		},
+	}, func(args map[string]any) error {
+		_, err := tool.Preview(t.Context(), request(constants.PaymentLinkToolName, args))
+		return err
+	})
+}
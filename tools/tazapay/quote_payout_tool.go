@@ -0,0 +1,88 @@
+package tazapay
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/pkg/validation"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// QuotePayoutTool defines the tool structure
+type QuotePayoutTool struct {
+	logger *slog.Logger
+	client *api.Client
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.QuotePayoutToolName,
+		Category: constants.CategoryPayouts,
+		ReadOnly: true,
+		Scope:    constants.ScopePayoutsRead,
+	}, func(deps registry.Deps) types.Tool {
+		return NewQuotePayoutTool(deps.Logger, deps.Client)
+	})
+}
+
+// NewQuotePayoutTool returns a new instance of the QuotePayoutTool
+func NewQuotePayoutTool(logger *slog.Logger, client *api.Client) *QuotePayoutTool {
+	logger.Info("Initializing QuotePayoutTool")
+
+	return &QuotePayoutTool{
+		logger: logger,
+		client: client,
+	}
+}
+
+// Definition registers this tool with the MCP platform
+func (*QuotePayoutTool) Definition() mcp.Tool {
+	return mcp.NewTool(
+		constants.QuotePayoutToolName,
+		mcp.WithDescription(constants.QuotePayoutToolDesc),
+		mcp.WithOutputSchema[types.PayoutQuote](),
+		mcp.WithNumber(constants.PayoutAmountField, mcp.Required(), mcp.Description(constants.PayoutAmountDesc)),
+		mcp.WithString(constants.PayoutCurrencyField, mcp.Required(), mcp.Description(constants.PayoutCurrencyDesc)),
+		mcp.WithString(constants.HoldingCurrencyField, mcp.Required(), mcp.Description(constants.HoldingCurrencyDesc)),
+	)
+}
+
+// Handle processes the tool request and returns a result
+func (t *QuotePayoutTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+
+	currency, err := utils.RequiredString(t.logger, args, constants.PayoutCurrencyField)
+	if err != nil {
+		return nil, err
+	}
+
+	holding, err := utils.RequiredString(t.logger, args, constants.HoldingCurrencyField)
+	if err != nil {
+		return nil, err
+	}
+
+	v := validation.New()
+
+	currency = v.Currency(constants.PayoutCurrencyField, currency)
+	holding = v.Currency(constants.HoldingCurrencyField, holding)
+	amount := utils.ValidAmount(t.logger, v, args, constants.PayoutAmountField, constants.PayoutCurrencyField, currency)
+
+	if err = v.Err(); err != nil {
+		return nil, err
+	}
+
+	quote, err := t.client.QuotePayout(ctx, holding, amount)
+	if err != nil {
+		t.logger.Error("payout quote failed", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("QuotePayout failed: %w", err)
+	}
+
+	return utils.StructuredResult(t.client.Environment(), quote, utils.FormatPayoutQuote(quote)), nil
+}
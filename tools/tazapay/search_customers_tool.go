@@ -0,0 +1,92 @@
+package tazapay
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/pkg/validation"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// SearchCustomersTool defines the tool structure
+type SearchCustomersTool struct {
+	logger *slog.Logger
+	client *api.Client
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.SearchCustomersToolName,
+		Category: constants.CategoryCustomers,
+		ReadOnly: true,
+		Scope:    constants.ScopePaymentsRead,
+	}, func(deps registry.Deps) types.Tool {
+		return NewSearchCustomersTool(deps.Logger, deps.Client)
+	})
+}
+
+// NewSearchCustomersTool returns a new instance of the SearchCustomersTool
+func NewSearchCustomersTool(logger *slog.Logger, client *api.Client) *SearchCustomersTool {
+	logger.Info("Initializing SearchCustomersTool")
+
+	return &SearchCustomersTool{
+		logger: logger,
+		client: client,
+	}
+}
+
+// Definition registers this tool with the MCP platform
+func (*SearchCustomersTool) Definition() mcp.Tool {
+	return mcp.NewTool(
+		constants.SearchCustomersToolName,
+		mcp.WithDescription(constants.SearchCustomersToolDesc),
+		mcp.WithOutputSchema[types.CustomerSearch](),
+		mcp.WithString(constants.CustomerQueryField, mcp.Required(), mcp.Description(constants.CustomerQueryDesc)),
+	)
+}
+
+// Handle processes the tool request and returns a result
+func (t *SearchCustomersTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	query, err := utils.RequiredString(t.logger, req.GetArguments(), constants.CustomerQueryField)
+	if err != nil {
+		return nil, err
+	}
+
+	v := validation.New()
+	if query = v.Required(constants.CustomerQueryField, query); v.Err() != nil {
+		return nil, v.Err()
+	}
+
+	result, err := t.client.SearchCustomers(ctx, query)
+	if err != nil {
+		t.logger.Error("customer API call failed", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("SearchCustomers failed: %w", err)
+	}
+
+	var b strings.Builder
+
+	if len(result.Customers) == 0 {
+		fmt.Fprintf(&b, "No customers match %q.", query)
+	} else {
+		fmt.Fprintf(&b, "%d customer(s) match %q:\n", len(result.Customers), query)
+
+		for i := range result.Customers {
+			b.WriteString("- " + utils.FormatCustomer(&result.Customers[i]) + "\n")
+		}
+	}
+
+	if result.Truncated {
+		fmt.Fprintf(&b, "\nOnly the latest %d customers were searched; use %s to page further.",
+			constants.MaxCustomerSearchPages*constants.CustomerSearchPageSize, constants.ListCustomersToolName)
+	}
+
+	return utils.StructuredResult(t.client.Environment(), result, b.String()), nil
+}
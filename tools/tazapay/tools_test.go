@@ -0,0 +1,100 @@
+package tazapay_test
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// argsCase is a tool call whose arguments are validated.
+type argsCase struct {
+	name    string
+	args    map[string]any
+	wantErr error  // Sentinel the error must match, if any
+	want    string // Text the error must contain; both empty when the arguments are valid
+}
+
+var discard = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// newTestClient returns a client for a fake Tazapay API served by handler.
+// A nil handler fails the test on any API call, for arguments that must be
+// rejected before calling the API.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *api.Client {
+	t.Helper()
+
+	if handler == nil {
+		handler = func(_ http.ResponseWriter, r *http.Request) {
+			t.Errorf("unexpected API call: %s %s", r.Method, r.URL)
+		}
+	}
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	env := types.Environment{Name: constants.EnvSandbox, BaseURL: srv.URL}
+
+	return api.NewClient(env, "dG9rZW4=", discard, api.WithHTTPClient(srv.Client()))
+}
+
+// request returns a call of the named tool with args.
+func request(name string, args map[string]any) mcp.CallToolRequest {
+	var req mcp.CallToolRequest
+	req.Params.Name = name
+	req.Params.Arguments = args
+
+	return req
+}
+
+// with returns a copy of args with fields set from key, value pairs; a nil
+// value removes the field.
+func with(args map[string]any, kv ...any) map[string]any {
+	out := maps.Clone(args)
+
+	for i := 0; i+1 < len(kv); i += 2 {
+		if kv[i+1] == nil {
+			delete(out, kv[i].(string))
+		} else {
+			out[kv[i].(string)] = kv[i+1]
+		}
+	}
+
+	return out
+}
+
+// runCases checks the error returned by call for each case.
+func runCases(t *testing.T, cases []argsCase, call func(args map[string]any) error) {
+	t.Helper()
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := call(tc.args)
+
+			switch {
+			case tc.wantErr == nil && tc.want == "":
+				if err != nil {
+					t.Errorf("expected valid arguments, got: %v", err)
+				}
+
+			case err == nil:
+				t.Errorf("expected an error, got none")
+
+			case tc.wantErr != nil && !errors.Is(err, tc.wantErr):
+				t.Errorf("expected %v, got: %v", tc.wantErr, err)
+
+			case !strings.Contains(err.Error(), tc.want):
+				t.Errorf("expected an error containing %q, got: %v", tc.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package tazapay
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/tazapay/tazapay-mcp-server/constants"
+	api "github.com/tazapay/tazapay-mcp-server/pkg/tazapay"
+	"github.com/tazapay/tazapay-mcp-server/pkg/utils"
+	"github.com/tazapay/tazapay-mcp-server/tools/registry"
+	"github.com/tazapay/tazapay-mcp-server/types"
+)
+
+// UpdateCustomerTool defines the tool structure
+type UpdateCustomerTool struct {
+	logger *slog.Logger
+	client *api.Client
+}
+
+func init() {
+	registry.Register(types.ToolMetadata{
+		Name:     constants.UpdateCustomerToolName,
+		Category: constants.CategoryCustomers,
+		ReadOnly: false,
+		Scope:    constants.ScopePaymentsWrite,
+		Object:   constants.ObjectCustomer,
+	}, func(deps registry.Deps) types.Tool {
+		return NewUpdateCustomerTool(deps.Logger, deps.Client)
+	})
+}
+
+// NewUpdateCustomerTool returns a new instance of the UpdateCustomerTool
+func NewUpdateCustomerTool(logger *slog.Logger, client *api.Client) *UpdateCustomerTool {
+	logger.Info("Initializing UpdateCustomerTool")
+
+	return &UpdateCustomerTool{
+		logger: logger,
+		client: client,
+	}
+}
+
+// Definition registers this tool with the MCP platform
+func (*UpdateCustomerTool) Definition() mcp.Tool {
+	return mcp.NewTool(
+		constants.UpdateCustomerToolName,
+		mcp.WithDescription(constants.UpdateCustomerToolDesc),
+		mcp.WithOutputSchema[types.Customer](),
+		mcp.WithString(constants.CustomerIDField, mcp.Required(), mcp.Description(constants.CustomerIDDesc)),
+		mcp.WithString(constants.CustomerNameField, mcp.Description(constants.CustomerNameDesc)),
+		mcp.WithString(constants.CustomerEmailField, mcp.Description(constants.CustomerEmailDesc)),
+		mcp.WithString(constants.CustomerCountryField, mcp.Description(constants.CustomerCountryDesc)),
+		mcp.WithString(constants.CustomerPhoneCodeField, mcp.Description(constants.CustomerPhoneCodeDesc)),
+		mcp.WithString(constants.CustomerPhoneField, mcp.Description(constants.CustomerPhoneDesc)),
+		mcp.WithString(constants.CustomerReferenceField, mcp.Description(constants.CustomerReferenceDesc)),
+		mcp.WithObject(constants.MetadataField, mcp.Description(constants.CustomerMetadataDesc),
+			mcp.AdditionalProperties(map[string]any{"type": "string"})),
+	)
+}
+
+// Handle processes the tool request and returns a result
+func (t *UpdateCustomerTool) Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+
+	id, err := utils.RequiredString(t.logger, args, constants.CustomerIDField)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := extractCustomerArgs(t.logger, args, false)
+	if err != nil {
+		t.logger.Error("argument validation failed", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	payload := NewCustomerRequest(&p)
+	if payload.Name == "" && payload.Email == "" && payload.Country == "" && payload.Phone == nil &&
+		payload.ReferenceID == "" && len(payload.Metadata) == 0 {
+		return nil, constants.ErrNoCustomerChanges
+	}
+
+	customer, err := t.client.UpdateCustomer(ctx, id, &payload)
+	if err != nil {
+		t.logger.Error("customer API call failed", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("UpdateCustomer failed: %w", err)
+	}
+
+	t.logger.Info("customer updated", slog.String("customer_id", customer.ID))
+
+	return utils.StructuredResult(t.client.Environment(), customer, utils.FormatCustomer(customer)), nil
+}
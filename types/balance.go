@@ -4,12 +4,6 @@ type BalanceRequest struct {
 	Currency string `json:"currency,omitempty"` // Optional currency filter
 }
 
-type BalanceResponse struct {
-	Status  string           `json:"status"`
-	Message string           `json:"message"`
-	Data    BalanceDataBlock `json:"data"`
-}
-
 type BalanceDataBlock struct {
 	Object    string    `json:"object"`
 	UpdatedAt string    `json:"updated_at"`
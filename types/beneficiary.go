@@ -0,0 +1,68 @@
+package types
+
+// BeneficiaryParams represents the input fields extracted from MCP request
+type BeneficiaryParams struct {
+	Name            string
+	Type            string // "individual" or "business"
+	Email           string
+	DestinationType string // "bank", "wallet" or "local_payment_network"
+	Country         string
+	Currency        string
+	AccountNumber   string
+	BankName        string
+	BankCodes       map[string]string
+	WalletType      string
+	DepositKey      string
+	DepositKeyType  string
+}
+
+// BeneficiaryRequest defines the payload sent to the beneficiary API
+type BeneficiaryRequest struct {
+	Name               string             `json:"name"`
+	Type               string             `json:"type"`
+	Email              string             `json:"email,omitempty"`
+	DestinationDetails DestinationDetails `json:"destination_details"`
+}
+
+// DestinationDetails describes where payouts to a beneficiary are sent
+type DestinationDetails struct {
+	Type                string               `json:"type"`
+	Bank                *BankDetails         `json:"bank,omitempty"`
+	Wallet              *WalletDetails       `json:"wallet,omitempty"`
+	LocalPaymentNetwork *LocalPaymentNetwork `json:"local_payment_network,omitempty"`
+}
+
+// BankDetails holds a beneficiary bank account
+type BankDetails struct {
+	AccountNumber string            `json:"account_number,omitempty"`
+	BankName      string            `json:"bank_name,omitempty"`
+	Country       string            `json:"country"`
+	Currency      string            `json:"currency"`
+	BankCodes     map[string]string `json:"bank_codes,omitempty"`
+}
+
+// WalletDetails holds a beneficiary wallet such as PayPal
+type WalletDetails struct {
+	Type       string `json:"type"`
+	DepositKey string `json:"deposit_key"`
+	Currency   string `json:"currency,omitempty"`
+}
+
+// LocalPaymentNetwork holds a local rail such as PIX, UPI or PayNow
+type LocalPaymentNetwork struct {
+	Type           string `json:"type"`
+	DepositKey     string `json:"deposit_key"`
+	DepositKeyType string `json:"deposit_key_type,omitempty"`
+	Country        string `json:"country,omitempty"`
+	Currency       string `json:"currency,omitempty"`
+}
+
+// Beneficiary is the beneficiary object returned by the API
+type Beneficiary struct {
+	ID                 string             `json:"id"`
+	Name               string             `json:"name"`
+	Type               string             `json:"type"`
+	Email              string             `json:"email"`
+	DestinationDetails DestinationDetails `json:"destination_details"`
+	CreatedAt          string             `json:"created_at"`
+}
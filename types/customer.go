@@ -0,0 +1,54 @@
+package types
+
+// CustomerParams represents the input fields extracted from MCP request.
+// Empty fields are left unchanged by an update.
+type CustomerParams struct {
+	Name             string
+	Email            string
+	Country          string
+	PhoneCallingCode string
+	PhoneNumber      string
+	ReferenceID      string
+	Metadata         map[string]string
+}
+
+// CustomerRequest defines the payload sent to the customer API
+type CustomerRequest struct {
+	Name        string            `json:"name,omitempty"`
+	Email       string            `json:"email,omitempty"`
+	Country     string            `json:"country,omitempty"`
+	Phone       *Phone            `json:"phone,omitempty"`
+	ReferenceID string            `json:"reference_id,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+// Phone is a phone number split into calling code and number
+type Phone struct {
+	CallingCode string `json:"calling_code"`
+	Number      string `json:"number"`
+}
+
+// Customer is the customer object returned by the API
+type Customer struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Email       string            `json:"email"`
+	Country     string            `json:"country"`
+	Phone       *Phone            `json:"phone,omitempty"`
+	ReferenceID string            `json:"reference_id"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+	CreatedAt   string            `json:"created_at"`
+}
+
+// ListCustomersParams filters the customer list
+type ListCustomersParams struct {
+	ListParams
+	Email       string
+	ReferenceID string
+}
+
+// CustomerSearch holds the customers matching a search query
+type CustomerSearch struct {
+	Customers []Customer `json:"customers"`
+	Truncated bool       `json:"truncated"` // Not every customer was searched
+}
@@ -0,0 +1,12 @@
+package types
+
+// Environment describes the Tazapay API environment the tools talk to
+type Environment struct {
+	Name    string // "production", "sandbox" or "custom"
+	BaseURL string // Base URL including the API version, e.g. https://service.tazapay.com/v3
+}
+
+// URL joins the environment base URL with an API path
+func (e Environment) URL(path string) string {
+	return e.BaseURL + path
+}
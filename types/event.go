@@ -0,0 +1,31 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// Event is a webhook event received from Tazapay. Data holds the object the
+// event is about, as sent by Tazapay.
+type Event struct {
+	ID          string          `json:"id"`
+	Type        string          `json:"type"` // e.g. "checkout.paid" or "payout.failed"
+	ObjectID    string          `json:"object_id"`
+	Environment string          `json:"environment"` // The environment whose webhook received the event
+	CreatedAt   string          `json:"created_at"`
+	ReceivedAt  time.Time       `json:"received_at"`
+	Data        json.RawMessage `json:"data"`
+}
+
+// Object returns the kind of object the event is about, e.g. "checkout" for "checkout.paid".
+func (e Event) Object() string {
+	object, _, _ := strings.Cut(e.Type, ".")
+
+	return object
+}
+
+// EventList holds the events returned by the events tool
+type EventList struct {
+	Events []Event `json:"events"`
+}
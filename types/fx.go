@@ -1,8 +1,42 @@
 package types
 
+import "github.com/tazapay/tazapay-mcp-server/pkg/money"
+
 // FXParams represents the input fields extracted from MCP request
 type FXParams struct {
-	From   string
-	To     string
-	Amount float64
+	From      string
+	To        string
+	Amount    money.Money // In From, or in To when FixedSide is "destination"
+	FixedSide string
+}
+
+// FXRateRequest holds the query parameters of the FX rate API
+type FXRateRequest struct {
+	InitialCurrency string
+	FinalCurrency   string
+	Amount          money.Money // In InitialCurrency
+}
+
+// FXRate is the FX quote returned by the API
+type FXRate struct {
+	ID              string  `json:"id"`
+	InitialCurrency string  `json:"initial_currency"`
+	FinalCurrency   string  `json:"final_currency"`
+	Amount          float64 `json:"amount"`
+	ConvertedAmount float64 `json:"converted_amount"`
+	ExchangeRate    float64 `json:"exchange_rate"`
+	CreatedAt       string  `json:"created_at"`
+}
+
+// FXQuote is the result of the FX tool. Amounts are in minor units of their currency.
+type FXQuote struct {
+	QuoteID           string  `json:"quote_id,omitempty"` // Tazapay's quote ID; empty when the API returns none
+	From              string  `json:"from"`
+	To                string  `json:"to"`
+	FixedSide         string  `json:"fixed_side"`
+	SourceAmount      int64   `json:"source_amount"`
+	DestinationAmount int64   `json:"destination_amount"`
+	ExchangeRate      float64 `json:"exchange_rate"` // Units of To per unit of From
+	InverseRate       float64 `json:"inverse_rate"`  // Units of From per unit of To
+	QuotedAt          string  `json:"quoted_at"`
 }
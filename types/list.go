@@ -0,0 +1,15 @@
+package types
+
+// ListParams holds the cursor pagination parameters shared by list endpoints
+type ListParams struct {
+	Limit         int
+	StartingAfter string
+	EndingBefore  string
+}
+
+// List is the paginated collection returned by list endpoints
+type List[T any] struct {
+	Object  string `json:"object"`
+	Data    []T    `json:"data"`
+	HasMore bool   `json:"has_more"`
+}
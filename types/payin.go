@@ -0,0 +1,42 @@
+package types
+
+// Payin is the payin object returned by the API. A payin collects one
+// payment and can have several payment attempts.
+type Payin struct {
+	ID                     string          `json:"id"`
+	Status                 string          `json:"status"`
+	Amount                 int64           `json:"amount"`
+	AmountPaid             int64           `json:"amount_paid"`
+	InvoiceCurrency        string          `json:"invoice_currency"`
+	Customer               string          `json:"customer"`
+	CustomerDetails        CustomerDetails `json:"customer_details"`
+	ReferenceID            string          `json:"reference_id"`
+	TransactionDescription string          `json:"transaction_description"`
+	LatestPaymentAttempt   string          `json:"latest_payment_attempt"`
+	PaymentAttempts        int             `json:"payment_attempts_count,omitempty"`
+	CreatedAt              string          `json:"created_at"`
+}
+
+// PaymentMethodDetails describes the payment method used by an attempt
+type PaymentMethodDetails struct {
+	Type   string       `json:"type"`
+	Card   *CardDetails `json:"card,omitempty"`
+	Wallet string       `json:"wallet,omitempty"` // e.g. "gcash"; set for wallets and local payment methods
+	Bank   string       `json:"bank,omitempty"`   // Set for bank transfers and online banking
+}
+
+// CardDetails holds the non-sensitive details of a card
+type CardDetails struct {
+	Brand    string `json:"brand"`
+	Last4    string `json:"last4"`
+	Country  string `json:"country,omitempty"`
+	Funding  string `json:"funding,omitempty"` // "credit", "debit" or "prepaid"
+	ExpMonth int    `json:"exp_month,omitempty"`
+	ExpYear  int    `json:"exp_year,omitempty"`
+}
+
+// ListPaymentAttemptsParams filters the payment attempt list
+type ListPaymentAttemptsParams struct {
+	ListParams
+	Payin string
+}
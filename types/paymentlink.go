@@ -1,5 +1,7 @@
 package types
 
+import "github.com/tazapay/tazapay-mcp-server/pkg/money"
+
 // PaymentLinkParams represents the input fields extracted from MCP request
 type PaymentLinkParams struct {
 	InvoiceCurrency string
@@ -7,13 +9,115 @@ type PaymentLinkParams struct {
 	CustomerName    string
 	CustomerEmail   string
 	CustomerCountry string
-	PaymentAmount   float64
+	Customer        string // ID of an existing customer, used instead of name, email and country
+	PaymentAmount   money.Money
+
+	// Optional checkout options
+	ReferenceID    string
+	SuccessURL     string
+	CancelURL      string
+	WebhookURL     string
+	ExpiresAt      string // RFC 3339, in the future
+	PaymentMethods []string
+	Items          []CheckoutItem
+	Shipping       *AddressDetails
+	Billing        *AddressDetails
+	Metadata       map[string]string
 }
 
 // PaymentLinkRequest defines the payload sent to the internal API
 type PaymentLinkRequest struct {
-	CustomerDetails        map[string]string `json:"customer_details"`
+	Customer               string            `json:"customer,omitempty"`
+	CustomerDetails        map[string]string `json:"customer_details,omitempty"`
 	InvoiceCurrency        string            `json:"invoice_currency"`
 	TransactionDescription string            `json:"transaction_description"`
 	Amount                 int64             `json:"amount"`
+	ReferenceID            string            `json:"reference_id,omitempty"`
+	SuccessURL             string            `json:"success_url,omitempty"`
+	CancelURL              string            `json:"cancel_url,omitempty"`
+	WebhookURL             string            `json:"webhook_url,omitempty"`
+	ExpiresAt              string            `json:"expires_at,omitempty"`
+	PaymentMethods         []string          `json:"payment_methods,omitempty"`
+	Items                  []CheckoutItem    `json:"items,omitempty"`
+	ShippingDetails        *AddressDetails   `json:"shipping_details,omitempty"`
+	BillingDetails         *AddressDetails   `json:"billing_details,omitempty"`
+	Metadata               map[string]string `json:"metadata,omitempty"`
+}
+
+// CheckoutItem is a line item shown on the checkout page. Amount is the unit
+// price in minor units of the invoice currency.
+type CheckoutItem struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Quantity    int    `json:"quantity"`
+	Amount      int64  `json:"amount"`
+}
+
+// AddressDetails is a named shipping or billing address
+type AddressDetails struct {
+	Name    string  `json:"name,omitempty"`
+	Address Address `json:"address"`
+}
+
+// Address is a postal address
+type Address struct {
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city"`
+	State      string `json:"state,omitempty"`
+	Country    string `json:"country"`
+	PostalCode string `json:"postal_code,omitempty"`
+}
+
+// Checkout is the checkout object returned by the API
+type Checkout struct {
+	ID                     string           `json:"id"`
+	URL                    string           `json:"url"`
+	Status                 string           `json:"status"`
+	PaymentStatus          string           `json:"payment_status"`
+	Amount                 int64            `json:"amount"`
+	AmountPaid             int64            `json:"amount_paid"`
+	InvoiceCurrency        string           `json:"invoice_currency"`
+	ReferenceID            string           `json:"reference_id"`
+	TransactionDescription string           `json:"transaction_description"`
+	Customer               string           `json:"customer"`
+	CustomerDetails        CustomerDetails  `json:"customer_details"`
+	PaymentAttempts        []PaymentAttempt `json:"payment_attempts"`
+	ExpiresAt              string           `json:"expires_at"`
+	CreatedAt              string           `json:"created_at"`
+}
+
+// CustomerDetails identifies the payer of a checkout
+type CustomerDetails struct {
+	Name    string `json:"name"`
+	Email   string `json:"email"`
+	Country string `json:"country"`
+}
+
+// PaymentAttempt is a single attempt by the customer to pay a checkout
+type PaymentAttempt struct {
+	ID                   string                `json:"id"`
+	Payin                string                `json:"payin,omitempty"`
+	Status               string                `json:"status"`
+	StatusDescription    string                `json:"status_description"`
+	DeclineCode          string                `json:"decline_code,omitempty"`
+	Amount               int64                 `json:"amount"`
+	Currency             string                `json:"currency"`
+	PaymentMethodType    string                `json:"payment_method_type"`
+	PaymentMethodDetails *PaymentMethodDetails `json:"payment_method_details,omitempty"`
+	CreatedAt            string                `json:"created_at"`
+
+	// DeclineExplanation is not returned by the API; the server fills it in from DeclineCode
+	DeclineExplanation string `json:"decline_explanation,omitempty"`
+}
+
+// ListCheckoutsParams filters the checkout list
+type ListCheckoutsParams struct {
+	ListParams
+	ReferenceID string
+}
+
+// CheckoutLookup holds the checkouts found by ID or reference
+type CheckoutLookup struct {
+	Checkouts []Checkout `json:"checkouts"`
 }
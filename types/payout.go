@@ -0,0 +1,49 @@
+package types
+
+import "github.com/tazapay/tazapay-mcp-server/pkg/money"
+
+// PayoutParams represents the input fields extracted from MCP request
+type PayoutParams struct {
+	Beneficiary     string
+	Amount          money.Money
+	Currency        string
+	HoldingCurrency string
+	Purpose         string
+	Description     string
+	ReferenceID     string
+}
+
+// PayoutRequest defines the payload sent to the payout API
+type PayoutRequest struct {
+	Beneficiary            string `json:"beneficiary"`
+	Amount                 int64  `json:"amount"`
+	Currency               string `json:"currency"`
+	HoldingCurrency        string `json:"holding_currency"`
+	Purpose                string `json:"purpose"`
+	TransactionDescription string `json:"transaction_description,omitempty"`
+	ReferenceID            string `json:"reference_id,omitempty"`
+}
+
+// Payout is the payout object returned by the API
+type Payout struct {
+	ID              string  `json:"id"`
+	Beneficiary     string  `json:"beneficiary"`
+	Amount          int64   `json:"amount"`
+	Currency        string  `json:"currency"`
+	HoldingCurrency string  `json:"holding_currency"`
+	ExchangeRate    float64 `json:"exchange_rate"`
+	Status          string  `json:"status"`
+	StatusMessage   string  `json:"status_message"`
+	ReferenceID     string  `json:"reference_id"`
+	CreatedAt       string  `json:"created_at"`
+}
+
+// PayoutQuote is the FX quote shown before a payout is created
+// Amounts are in minor units of their currency.
+type PayoutQuote struct {
+	HoldingCurrency string  `json:"holding_currency"`
+	PayoutCurrency  string  `json:"payout_currency"`
+	PayoutAmount    int64   `json:"payout_amount"`
+	ExchangeRate    float64 `json:"exchange_rate"` // Units of payout currency per unit of holding currency
+	HoldingAmount   int64   `json:"holding_amount"`
+}
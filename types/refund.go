@@ -0,0 +1,41 @@
+package types
+
+import "github.com/tazapay/tazapay-mcp-server/pkg/money"
+
+// RefundParams represents the input fields extracted from MCP request
+type RefundParams struct {
+	Payin       string
+	Checkout    string
+	Amount      money.Money // Zero means a full refund
+	Currency    string
+	Reason      string
+	ReferenceID string
+}
+
+// RefundRequest defines the payload sent to the refund API
+type RefundRequest struct {
+	Payin       string `json:"payin,omitempty"`
+	Checkout    string `json:"checkout,omitempty"`
+	Amount      int64  `json:"amount,omitempty"`
+	Currency    string `json:"currency,omitempty"`
+	Reason      string `json:"reason,omitempty"`
+	ReferenceID string `json:"reference_id,omitempty"`
+}
+
+// Refund is the refund object returned by the API
+type Refund struct {
+	ID          string `json:"id"`
+	Payin       string `json:"payin"`
+	Amount      int64  `json:"amount"`
+	Currency    string `json:"currency"`
+	Status      string `json:"status"`
+	Reason      string `json:"reason"`
+	ReferenceID string `json:"reference_id"`
+	CreatedAt   string `json:"created_at"`
+}
+
+// ListRefundsParams filters the refund list
+type ListRefundsParams struct {
+	ListParams
+	Payin string
+}
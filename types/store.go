@@ -0,0 +1,43 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ToolCall records one tool invocation
+type ToolCall struct {
+	Tool        string         `json:"tool"`
+	Principal   string         `json:"principal,omitempty"` // Authenticated caller; empty over stdio
+	Environment string         `json:"environment"`
+	Arguments   map[string]any `json:"arguments,omitempty"`
+	Outcome     string         `json:"outcome"` // "ok", "confirmation_required", "cancelled" or "error"
+	ErrorCode   string         `json:"error_code,omitempty"`
+	ObjectID    string         `json:"object_id,omitempty"` // Tazapay object created or changed by the call
+	StartedAt   time.Time      `json:"started_at"`
+	DurationMS  int64          `json:"duration_ms"`
+}
+
+// StoredObject is a Tazapay object created or changed through a tool. Data
+// holds the summary fields of the object as returned by the tool, without
+// personal data.
+type StoredObject struct {
+	ID          string          `json:"id"`
+	Kind        string          `json:"kind"` // e.g. "checkout" or "payout"
+	Tool        string          `json:"tool"`
+	Principal   string          `json:"principal,omitempty"` // Caller that created the object; empty over stdio
+	Environment string          `json:"environment"`
+	Data        json.RawMessage `json:"data"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+// ToolCallList holds the tool calls returned by the history tools
+type ToolCallList struct {
+	Calls []ToolCall `json:"calls"`
+}
+
+// StoredObjectList holds the objects returned by the history tools
+type StoredObjectList struct {
+	Objects []StoredObject `json:"objects"`
+}
@@ -15,3 +15,19 @@ type Tool interface {
 	// Handle processes the tool call
 	Handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error)
 }
+
+// Previewer is implemented by tools that can describe what a call will do
+// before it runs. The confirmation gate shows this preview to the user.
+type Previewer interface {
+	Preview(ctx context.Context, req mcp.CallToolRequest) (string, error)
+}
+
+// ToolMetadata describes a tool to the registry, which decides from it
+// whether the tool is exposed and who may call it.
+type ToolMetadata struct {
+	Name     string // Tool name, as in its definition
+	Category string // Group of related tools, e.g. "refunds"
+	ReadOnly bool   // Set for tools that never create or change Tazapay objects
+	Scope    string // OAuth scope a caller needs to use the tool
+	Object   string // Kind of Tazapay object the tool creates or changes, kept in the store
+}